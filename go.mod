@@ -3,6 +3,7 @@ module github.com/starkbank/sdk-go
 go 1.17
 
 require (
+	github.com/starkbank/ecdsa-go/v2 v2.0.0
 	github.com/starkinfra/core-go v1.0.0
 	github.com/stretchr/testify v1.9.0
 )
@@ -11,6 +12,5 @@ require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/iancoleman/strcase v0.2.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	github.com/starkbank/ecdsa-go/v2 v2.0.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
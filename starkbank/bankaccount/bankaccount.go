@@ -0,0 +1,170 @@
+package bankaccount
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"strings"
+)
+
+//	BankAccount struct
+//
+//	A Brazilian bank account split into its branch, account number and verifier digits, as
+//	informed on the BranchCode and AccountNumber of a Transfer. Use Parse to build it from
+//	formatted strings and check its verifier digits without calling the Stark Bank API.
+//
+//	Attributes:
+//	- BankCode [string]: COMPE code of the bank. ex: "341"
+//	- Branch [string]: branch number without its verifier digit. ex: "1357"
+//	- BranchDigit [string]: branch verifier digit, if the bank uses one. ex: "9"
+//	- Number [string]: account number without its verifier digit. ex: "876543"
+//	- Digit [string]: account verifier digit. ex: "2"
+
+type BankAccount struct {
+	BankCode    string
+	Branch      string
+	BranchDigit string
+	Number      string
+	Digit       string
+}
+
+type layout struct {
+	name          string
+	branchDigit   bool
+	accountLength int
+	branchCheck   func(branch string) string
+	accountCheck  func(branch string, number string) string
+	skipLonger    bool
+}
+
+var layouts = map[string]layout{
+	"001": {name: "Banco do Brasil", branchDigit: true, accountLength: 8, branchCheck: bancoDoBrasil, accountCheck: func(branch string, number string) string { return bancoDoBrasil(number) }},
+	"033": {name: "Santander", accountLength: 8, accountCheck: santander},
+	"104": {name: "Caixa", accountLength: 11, accountCheck: caixa, skipLonger: true},
+	"237": {name: "Bradesco", branchDigit: true, accountLength: 7, branchCheck: bradescoBranch, accountCheck: func(branch string, number string) string { return bradescoAccount(number) }},
+	"341": {name: "Itaú", accountLength: 5, accountCheck: itau},
+}
+
+func IsSupported(bankCode string) bool {
+	//	Return true if the verifier digits of the bank with this COMPE code are checked by Parse. ex: "237"
+	_, ok := layouts[bankCode]
+	return ok
+}
+
+func Parse(bankCode string, branchCode string, accountNumber string) (BankAccount, Error.StarkErrors) {
+	//	Parse and validate a bank account
+	//
+	//	Spaces and dots are removed and numbers shorter than the bank layout are padded with zeros.
+	//	The verifier digits of Banco do Brasil (001), Santander (033), Caixa (104), Bradesco (237) and
+	//	Itaú (341) accounts are checked, while accounts of other banks only have their format checked.
+	//	Caixa accounts are informed with their 3-digit operation code before the account number,
+	//	and the longer accounts of its newer layout only have their format checked.
+	//
+	//	Parameters (required):
+	//	- bankCode [string]: COMPE code of the bank. ex: "341"
+	//	- branchCode [string]: branch number. Use '-' in case there is a verifier digit. ex: "1357-9"
+	//	- accountNumber [string]: account number. Use '-' before the verifier digit. ex: "876543-2"
+	//
+	//	Return:
+	//	- BankAccount struct
+	account := BankAccount{BankCode: bankCode}
+	var errors []Error.StarkError
+	add := func(message string, args ...interface{}) {
+		errors = append(errors, Error.StarkError{Code: "invalidBankAccount", Message: fmt.Sprintf(message, args...)})
+	}
+
+	account.Branch, account.BranchDigit = split(branchCode)
+	account.Number, account.Digit = split(accountNumber)
+	if !isDigits(account.Branch) || len(account.Branch) > 4 || !isCheckDigit(account.BranchDigit, true) {
+		add("Branch code must have up to 4 digits and an optional verifier digit after '-', but it is %q", branchCode)
+	}
+	if !isDigits(account.Number) || len(account.Number) > 20 || account.Digit == "" || !isCheckDigit(account.Digit, false) {
+		add("Account number must have digits and a verifier digit after '-', but it is %q", accountNumber)
+	}
+	if errors != nil {
+		return account, Error.StarkErrors{Errors: errors}
+	}
+	account.Branch = pad(account.Branch, 4)
+
+	bank, ok := layouts[bankCode]
+	if !ok {
+		return account, Error.StarkErrors{}
+	}
+	number := strings.TrimLeft(account.Number, "0")
+	if bank.skipLonger && len(number) > bank.accountLength {
+		return account, Error.StarkErrors{}
+	}
+	if len(number) > bank.accountLength {
+		add("%s account numbers must have up to %d digits, but %q has %d", bank.name, bank.accountLength, account.Number, len(number))
+		return account, Error.StarkErrors{Errors: errors}
+	}
+	account.Number = pad(number, bank.accountLength)
+
+	if bank.branchDigit && account.BranchDigit != "" && bank.branchCheck(account.Branch) != strings.ToUpper(account.BranchDigit) {
+		add("%s branch %s verifier digit must be %s, but it is %s", bank.name, account.Branch, bank.branchCheck(account.Branch), account.BranchDigit)
+	}
+	if !bank.branchDigit && account.BranchDigit != "" {
+		add("%s branches have no verifier digit, but %q was informed", bank.name, branchCode)
+	}
+	if expected := bank.accountCheck(account.Branch, account.Number); expected != strings.ToUpper(account.Digit) {
+		add("%s account %s verifier digit must be %s, but it is %s", bank.name, account.Number, expected, account.Digit)
+	}
+	account.BranchDigit = strings.ToUpper(account.BranchDigit)
+	account.Digit = strings.ToUpper(account.Digit)
+	if errors != nil {
+		return account, Error.StarkErrors{Errors: errors}
+	}
+	return account, Error.StarkErrors{}
+}
+
+func Validate(bankCode string, branchCode string, accountNumber string) Error.StarkErrors {
+	//	Validate a bank account. See Parse for the rules applied
+	_, err := Parse(bankCode, branchCode, accountNumber)
+	return err
+}
+
+func (a BankAccount) BranchCode() string {
+	//	Get the branch formatted as expected by Transfer.BranchCode. ex: "1357-9"
+	if a.BranchDigit == "" {
+		return a.Branch
+	}
+	return a.Branch + "-" + a.BranchDigit
+}
+
+func (a BankAccount) AccountNumber() string {
+	//	Get the account number formatted as expected by Transfer.AccountNumber. ex: "876543-2"
+	return a.Number + "-" + a.Digit
+}
+
+func split(code string) (string, string) {
+	code = strings.NewReplacer(" ", "", ".", "").Replace(code)
+	if index := strings.LastIndex(code, "-"); index >= 0 {
+		return code[:index], code[index+1:]
+	}
+	return code, ""
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, char := range value {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isCheckDigit(value string, optional bool) bool {
+	if value == "" {
+		return optional
+	}
+	return len(value) == 1 && (isDigits(value) || strings.ContainsAny(value, "xXpP"))
+}
+
+func pad(value string, length int) string {
+	if len(value) >= length {
+		return value
+	}
+	return strings.Repeat("0", length-len(value)) + value
+}
@@ -0,0 +1,87 @@
+package bankaccount
+
+import (
+	"strconv"
+)
+
+func weightedSum(digits string, weights []int, units bool) int {
+	sum := 0
+	for i, char := range digits {
+		product := int(char-'0') * weights[i]
+		if units {
+			product %= 10
+		}
+		sum += product
+	}
+	return sum
+}
+
+func rightToLeft(length int, from int, to int) []int {
+	weights := make([]int, length)
+	weight := from
+	for i := length - 1; i >= 0; i-- {
+		weights[i] = weight
+		weight++
+		if weight > to {
+			weight = from
+		}
+	}
+	return weights
+}
+
+func bancoDoBrasil(digits string) string {
+	digit := 11 - weightedSum(digits, rightToLeft(len(digits), 2, 9), false)%11
+	switch digit {
+	case 10:
+		return "X"
+	case 11:
+		return "0"
+	}
+	return strconv.Itoa(digit)
+}
+
+func bradescoBranch(branch string) string {
+	digit := 11 - weightedSum(branch, rightToLeft(len(branch), 2, 9), false)%11
+	switch digit {
+	case 10:
+		return "P"
+	case 11:
+		return "0"
+	}
+	return strconv.Itoa(digit)
+}
+
+func bradescoAccount(number string) string {
+	remainder := weightedSum(number, rightToLeft(len(number), 2, 7), false) % 11
+	switch remainder {
+	case 0:
+		return "0"
+	case 1:
+		return "P"
+	}
+	return strconv.Itoa(11 - remainder)
+}
+
+func itau(branch string, number string) string {
+	sum := 0
+	for i, char := range branch + number {
+		product := int(char-'0') * (2 - i%2)
+		sum += product/10 + product%10
+	}
+	return strconv.Itoa((10 - sum%10) % 10)
+}
+
+func santander(branch string, number string) string {
+	weights := []int{9, 7, 3, 1, 0, 0, 9, 7, 1, 3, 1, 9, 7, 3}
+	sum := weightedSum(branch+"00"+number, weights, true)
+	return strconv.Itoa((10 - sum%10) % 10)
+}
+
+func caixa(branch string, number string) string {
+	weights := []int{8, 7, 6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	digit := weightedSum(branch+number, weights, false) * 10 % 11
+	if digit == 10 {
+		digit = 0
+	}
+	return strconv.Itoa(digit)
+}
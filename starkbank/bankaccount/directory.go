@@ -0,0 +1,150 @@
+package bankaccount
+
+import (
+	"fmt"
+	Institution "github.com/starkbank/sdk-go/starkbank/institution"
+	Transfer "github.com/starkbank/sdk-go/starkbank/transfer"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"strings"
+	"sync"
+)
+
+//	Institution Directory struct
+//
+//	A Directory resolves which rail a Transfer will use from its BankCode. An 8-digit ISPB is
+//	sent by Pix and a 3-digit COMPE code is sent by TED, as done by the Stark Bank API, and the
+//	receiving Institution must be registered on that Central Bank service. The Institutions are
+//	retrieved from the API on first use unless they are informed. If they cannot be retrieved,
+//	the error is returned by every later Resolve, so a new Directory must be used to try again.
+//
+//	Parameters (optional):
+//	- Institutions [slice of Institution structs, default nil]: Institutions recognized by the Central Bank. Retrieved with institution.Query if nil
+//	- User [Organization/Project struct, default nil]: Organization or Project struct used to retrieve the Institutions. Not necessary if starkbank.User was set before
+
+type Directory struct {
+	Institutions []Institution.Institution
+	User         user.User
+	mutex        sync.Mutex
+	spi          map[string]Institution.Institution
+	str          map[string]Institution.Institution
+	loadError    Error.StarkErrors
+}
+
+//	Transfer Route struct
+//
+//	Attributes:
+//	- Rail [string]: rail used by the Transfer: "pix" or "ted". ex: "pix"
+//	- Institution [Institution struct]: receiving Institution
+//	- CompeCode [string]: COMPE code of the receiving Institution, used to validate the account verifier digits. Empty if it is not registered on STR. ex: "341"
+
+type Route struct {
+	Rail        string
+	Institution Institution.Institution
+	CompeCode   string
+}
+
+const (
+	Pix = "pix"
+	Ted = "ted"
+)
+
+func (d *Directory) Resolve(transfer Transfer.Transfer) (Route, Error.StarkErrors) {
+	//	Resolve the rail of a Transfer and validate its bank account
+	//
+	//	Encrypted or masked accounts, such as the ones returned by the DICT and used by pixkey.ToTransfer,
+	//	are accepted by the Stark Bank API as they are, so their verifier digits are not validated.
+	//
+	//	Parameters (required):
+	//	- transfer [Transfer struct]: Transfer with BankCode, BranchCode and AccountNumber. ex: Transfer.Transfer{BankCode: "341", BranchCode: "2545", AccountNumber: "02366-1"}
+	//
+	//	Return:
+	//	- Route struct
+	err := d.load()
+	if err.Errors != nil {
+		return Route{}, err
+	}
+
+	var route Route
+	var ok bool
+	switch {
+	case len(transfer.BankCode) == 8 && isDigits(transfer.BankCode):
+		route.Rail = Pix
+		route.Institution, ok = d.spi[transfer.BankCode]
+		if !ok {
+			return route, invalidBankCode(fmt.Sprintf("No institution is registered on Pix with ISPB %s", transfer.BankCode))
+		}
+	case len(transfer.BankCode) == 3 && isDigits(transfer.BankCode):
+		route.Rail = Ted
+		route.Institution, ok = d.str[transfer.BankCode]
+		if !ok {
+			return route, invalidBankCode(fmt.Sprintf("No institution is registered on TED with code %s", transfer.BankCode))
+		}
+	default:
+		return route, invalidBankCode(fmt.Sprintf("Bank code must be an 8-digit ISPB for Pix or a 3-digit COMPE code for TED, but it is %q", transfer.BankCode))
+	}
+
+	if route.Institution.StrCode == "" {
+		return route, Error.StarkErrors{}
+	}
+	route.CompeCode = pad(route.Institution.StrCode, 3)
+	if isEncrypted(transfer.BranchCode) || isEncrypted(transfer.AccountNumber) {
+		return route, Error.StarkErrors{}
+	}
+	return route, Validate(route.CompeCode, transfer.BranchCode, transfer.AccountNumber)
+}
+
+func (d *Directory) load() Error.StarkErrors {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.spi != nil || d.loadError.Errors != nil {
+		return d.loadError
+	}
+
+	institutions := d.Institutions
+	if institutions == nil {
+		var errors []Error.StarkError
+		results, errorChannel := Institution.Query(nil, d.User)
+		loop:
+		for {
+			select {
+			case err := <-errorChannel:
+				errors = append(errors, err.Errors...)
+			case institution, ok := <-results:
+				if !ok {
+					break loop
+				}
+				institutions = append(institutions, institution)
+			}
+		}
+		if errors != nil {
+			d.loadError = Error.StarkErrors{Errors: errors}
+			return d.loadError
+		}
+	}
+
+	d.spi = map[string]Institution.Institution{}
+	d.str = map[string]Institution.Institution{}
+	for _, institution := range institutions {
+		if institution.SpiCode != "" {
+			d.spi[institution.SpiCode] = institution
+		}
+		if institution.StrCode != "" {
+			d.str[pad(institution.StrCode, 3)] = institution
+		}
+	}
+	return Error.StarkErrors{}
+}
+
+func isEncrypted(code string) bool {
+	for _, char := range code {
+		if !(char >= '0' && char <= '9') && !strings.ContainsRune("-. Xx", char) {
+			return true
+		}
+	}
+	return false
+}
+
+func invalidBankCode(message string) Error.StarkErrors {
+	return Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidBankCode", Message: message}}}
+}
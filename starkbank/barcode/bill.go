@@ -0,0 +1,186 @@
+package barcode
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"strconv"
+)
+
+//	Utility or tax bill code struct
+//
+//	Fields encoded in the 48-digit line or 44-digit barcode of a bill in the collection
+//	(arrecadação) format, as used by UtilityPayment and TaxPayment.
+//
+//	Attributes:
+//	- Line [string]: 48-digit line. ex: "84670000001-7 43590024020-9 02405000243-5 84221010811-9"
+//	- BarCode [string]: 44-digit barcode. ex: "84670000001435900240200240500024384221010811"
+//	- Segment [string]: segment identifier: "1" city halls, "2" sanitation, "3" electricity and gas, "4" telecommunications, "5" government agencies, "6" companies identified by CNPJ, "7" traffic fines or "9" bank use. ex: "4"
+//	- Kind [string]: Utility or Tax, telling whether the bill is paid with utilitypayment.Create or taxpayment.Create. ex: "utility"
+//	- ValueType [string]: value type identifier, which also selects the check digit algorithm: "6" or "7" for modulo 10 and "8" or "9" for modulo 11. ex: "6"
+//	- IsReference [bool]: true if Amount is a reference quantity instead of an amount in cents (value types "7" and "9")
+//	- Amount [int]: amount in cents. ex: 14359 (= R$ 143,59)
+//	- CompanyId [string]: company or agency identifier. 8-digit CNPJ root for segment "6", otherwise 4 digits. ex: "0024"
+//	- FreeField [string]: remaining digits defined by the company or agency. ex: "0200240500024384221010811"
+
+type Bill struct {
+	Line        string
+	BarCode     string
+	Segment     string
+	Kind        string
+	ValueType   string
+	IsReference bool
+	Amount      int
+	CompanyId   string
+	FreeField   string
+}
+
+const (
+	Utility = "utility"
+	Tax     = "tax"
+)
+
+var taxSegments = map[string]bool{"1": true, "5": true, "7": true}
+
+func ParseBill(code string) (Bill, Error.StarkErrors) {
+	//	Parse a utility or tax bill line or barcode
+	//
+	//	Validate the check digits and extract the fields of a bill in the collection (arrecadação) format.
+	//	Bills of city halls, government agencies and traffic fines are taxes, the others are utilities.
+	//	Spaces, dots and other formatting characters are ignored.
+	//
+	//	Parameters (required):
+	//	- code [string]: 48-digit line or 44-digit barcode. ex: "84670000001-7 43590024020-9 02405000243-5 84221010811-9"
+	//
+	//	Return:
+	//	- Bill struct
+	digits := normalize(code)
+	var barCode string
+	var err Error.StarkErrors
+	switch {
+	case !isDigits(digits):
+		err = invalid("invalidBillCode", fmt.Sprintf("Bill code must have only digits: %q", code))
+	case len(digits) == 48:
+		barCode, err = BillBarCode(digits)
+	case len(digits) == 44:
+		barCode, err = digits, ValidateBillBarCode(digits)
+	default:
+		err = invalid("invalidBillCode", fmt.Sprintf("Bill code must have 48 (line) or 44 (barcode) digits, but %q has %d", code, len(digits)))
+	}
+	if err.Errors != nil {
+		return Bill{}, err
+	}
+
+	line, _ := BillLine(barCode)
+	amount, _ := strconv.Atoi(barCode[4:15])
+	companyEnd := 19
+	if barCode[1] == '6' {
+		companyEnd = 23
+	}
+	kind := Utility
+	if taxSegments[barCode[1:2]] {
+		kind = Tax
+	}
+	return Bill{
+		Line:        line,
+		BarCode:     barCode,
+		Segment:     barCode[1:2],
+		Kind:        kind,
+		ValueType:   barCode[2:3],
+		IsReference: barCode[2] == '7' || barCode[2] == '9',
+		Amount:      amount,
+		CompanyId:   barCode[15:companyEnd],
+		FreeField:   barCode[companyEnd:],
+	}, Error.StarkErrors{}
+}
+
+func ValidateBillBarCode(barCode string) Error.StarkErrors {
+	//	Validate the length, identifiers and the general check digit of a 44-digit bill barcode
+	//
+	//	Parameters (required):
+	//	- barCode [string]: 44-digit barcode. ex: "84670000001435900240200240500024384221010811"
+	//
+	//	Return:
+	//	- "invalidBillBarCode" error if the barcode is not valid
+	if len(barCode) != 44 || !isDigits(barCode) {
+		return invalid("invalidBillBarCode", fmt.Sprintf("Bill barcode must have 44 digits: %q", barCode))
+	}
+	if barCode[0] != '8' {
+		return invalid("invalidBillBarCode", fmt.Sprintf("Bill barcode %s must start with 8", barCode))
+	}
+	expected, ok := billCheckDigit(barCode[2], barCode[:3]+barCode[4:])
+	if !ok {
+		return invalid("invalidBillBarCode", fmt.Sprintf("Bill barcode %s value type %c must be 6, 7, 8 or 9", barCode, barCode[2]))
+	}
+	if barCode[3] != expected {
+		return invalid("invalidBillBarCode", fmt.Sprintf("Bill barcode %s check digit should be %c", barCode, expected))
+	}
+	return Error.StarkErrors{}
+}
+
+func BillBarCode(line string) (string, Error.StarkErrors) {
+	//	Convert a utility or tax bill line into its barcode
+	//
+	//	Parameters (required):
+	//	- line [string]: 48-digit line with or without formatting. ex: "84670000001-7 43590024020-9 02405000243-5 84221010811-9"
+	//
+	//	Return:
+	//	- 44-digit barcode. ex: "84670000001435900240200240500024384221010811"
+	digits := normalize(line)
+	if len(digits) != 48 || !isDigits(digits) {
+		return "", invalid("invalidBillLine", fmt.Sprintf("Bill line must have 48 digits, but %q has %d", line, len(digits)))
+	}
+
+	barCode := ""
+	for i := 0; i < 4; i++ {
+		block := digits[i*12 : i*12+11]
+		expected, ok := billCheckDigit(digits[2], block)
+		if !ok {
+			return "", invalid("invalidBillLine", fmt.Sprintf("Bill line %s value type %c must be 6, 7, 8 or 9", digits, digits[2]))
+		}
+		if digits[i*12+11] != expected {
+			return "", invalid("invalidBillLine", fmt.Sprintf("Bill line %s block %d check digit should be %c", digits, i+1, expected))
+		}
+		barCode += block
+	}
+
+	err := ValidateBillBarCode(barCode)
+	if err.Errors != nil {
+		return "", invalid("invalidBillLine", err.Errors[0].Message)
+	}
+	return barCode, Error.StarkErrors{}
+}
+
+func BillLine(barCode string) (string, Error.StarkErrors) {
+	//	Convert a utility or tax bill barcode into its line
+	//
+	//	Parameters (required):
+	//	- barCode [string]: 44-digit barcode. ex: "84670000001435900240200240500024384221010811"
+	//
+	//	Return:
+	//	- 48-digit line without formatting. ex: "846700000017435900240209024050002435842210108119"
+	err := ValidateBillBarCode(barCode)
+	if err.Errors != nil {
+		return "", err
+	}
+	line := ""
+	for i := 0; i < 4; i++ {
+		block := barCode[i*11 : (i+1)*11]
+		digit, _ := billCheckDigit(barCode[2], block)
+		line += block + string(digit)
+	}
+	return line, Error.StarkErrors{}
+}
+
+func billCheckDigit(valueType byte, digits string) (byte, bool) {
+	switch valueType {
+	case '6', '7':
+		return mod10(digits), true
+	case '8', '9':
+		remainder := mod11(digits, 9)
+		if remainder < 2 {
+			return '0', true
+		}
+		return byte('0' + 11 - remainder), true
+	}
+	return 0, false
+}
@@ -0,0 +1,184 @@
+package barcode
+
+import (
+	"fmt"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"strconv"
+	"time"
+)
+
+//	Boleto code struct
+//
+//	Fields encoded in a boleto line (linha digitável) or barcode, as used by Boleto and BoletoPayment.
+//
+//	Attributes:
+//	- Line [string]: 47-digit line. ex: "34191.09008 76038.597308 71444.640008 4 92150000028000"
+//	- BarCode [string]: 44-digit barcode. ex: "34194921500000280001090076038597307144464000"
+//	- BankCode [string]: COMPE code of the issuing bank. ex: "341"
+//	- Currency [string]: currency code. "9" stands for BRL. ex: "9"
+//	- DueFactor [int]: number of days that encodes the due date. 0 if the boleto has no due date. ex: 9215
+//	- Due [date.Date]: due date decoded from DueFactor. nil if the boleto has no due date
+//	- Amount [int]: amount in cents. 0 if the amount is filled in by the payer. ex: 28000 (= R$ 280,00)
+//	- FreeField [string]: 25-digit field defined by the issuing bank. ex: "1090076038597307144464000"
+
+type Boleto struct {
+	Line      string
+	BarCode   string
+	BankCode  string
+	Currency  string
+	DueFactor int
+	Due       *Date.Date
+	Amount    int
+	FreeField string
+}
+
+var dueFactorBase = time.Date(1997, 10, 7, 0, 0, 0, 0, time.UTC)
+
+const dueFactorCycle = 9000
+
+func ParseBoleto(code string) (Boleto, Error.StarkErrors) {
+	//	Parse a boleto line or barcode
+	//
+	//	Validate the check digits and extract the fields of a boleto line or barcode.
+	//	Spaces, dots and other formatting characters are ignored.
+	//
+	//	Parameters (required):
+	//	- code [string]: 47-digit line or 44-digit barcode. ex: "34191.09008 76038.597308 71444.640008 4 92150000028000"
+	//
+	//	Return:
+	//	- Boleto struct
+	digits := normalize(code)
+	var barCode string
+	var err Error.StarkErrors
+	switch {
+	case !isDigits(digits):
+		err = invalid("invalidBoletoCode", fmt.Sprintf("Boleto code must have only digits: %q", code))
+	case len(digits) == 47:
+		barCode, err = BoletoBarCode(digits)
+	case len(digits) == 44:
+		barCode, err = digits, ValidateBoletoBarCode(digits)
+	default:
+		err = invalid("invalidBoletoCode", fmt.Sprintf("Boleto code must have 47 (line) or 44 (barcode) digits, but %q has %d", code, len(digits)))
+	}
+	if err.Errors != nil {
+		return Boleto{}, err
+	}
+
+	line, _ := BoletoLine(barCode)
+	factor, _ := strconv.Atoi(barCode[5:9])
+	amount, _ := strconv.Atoi(barCode[9:19])
+	return Boleto{
+		Line:      line,
+		BarCode:   barCode,
+		BankCode:  barCode[0:3],
+		Currency:  barCode[3:4],
+		DueFactor: factor,
+		Due:       DueDate(factor, time.Now()),
+		Amount:    amount,
+		FreeField: barCode[19:44],
+	}, Error.StarkErrors{}
+}
+
+func ValidateBoletoBarCode(barCode string) Error.StarkErrors {
+	//	Validate the length and the general check digit of a 44-digit boleto barcode
+	//
+	//	Parameters (required):
+	//	- barCode [string]: 44-digit barcode. ex: "34194921500000280001090076038597307144464000"
+	//
+	//	Return:
+	//	- "invalidBoletoBarCode" error if the barcode is not valid
+	if len(barCode) != 44 || !isDigits(barCode) {
+		return invalid("invalidBoletoBarCode", fmt.Sprintf("Boleto barcode must have 44 digits: %q", barCode))
+	}
+	expected := boletoCheckDigit(barCode[:4] + barCode[5:])
+	if barCode[4] != expected {
+		return invalid("invalidBoletoBarCode", fmt.Sprintf("Boleto barcode %s check digit should be %c", barCode, expected))
+	}
+	return Error.StarkErrors{}
+}
+
+func BoletoBarCode(line string) (string, Error.StarkErrors) {
+	//	Convert a boleto line into its barcode
+	//
+	//	Parameters (required):
+	//	- line [string]: 47-digit line with or without formatting. ex: "34191.09008 76038.597308 71444.640008 4 92150000028000"
+	//
+	//	Return:
+	//	- 44-digit barcode. ex: "34194921500000280001090076038597307144464000"
+	digits := normalize(line)
+	if len(digits) != 47 || !isDigits(digits) {
+		return "", invalid("invalidBoletoLine", fmt.Sprintf("Boleto line must have 47 digits, but %q has %d", line, len(digits)))
+	}
+	fields := []string{digits[0:10], digits[10:21], digits[21:32]}
+	for i, field := range fields {
+		expected := mod10(field[:len(field)-1])
+		if field[len(field)-1] != expected {
+			return "", invalid("invalidBoletoLine", fmt.Sprintf("Boleto line %s field %d check digit should be %c", digits, i+1, expected))
+		}
+	}
+
+	barCode := digits[0:4] + digits[32:33] + digits[33:47] + digits[4:9] + digits[10:20] + digits[21:31]
+	err := ValidateBoletoBarCode(barCode)
+	if err.Errors != nil {
+		return "", invalid("invalidBoletoLine", fmt.Sprintf("Boleto line %s general check digit should be %c", digits, boletoCheckDigit(barCode[:4]+barCode[5:])))
+	}
+	return barCode, Error.StarkErrors{}
+}
+
+func BoletoLine(barCode string) (string, Error.StarkErrors) {
+	//	Convert a boleto barcode into its line
+	//
+	//	Parameters (required):
+	//	- barCode [string]: 44-digit barcode. ex: "34194921500000280001090076038597307144464000"
+	//
+	//	Return:
+	//	- 47-digit line without formatting. ex: "34191090087603859730871444640008492150000028000"
+	err := ValidateBoletoBarCode(barCode)
+	if err.Errors != nil {
+		return "", err
+	}
+	first := barCode[0:4] + barCode[19:24]
+	second := barCode[24:34]
+	third := barCode[34:44]
+	return first + string(mod10(first)) + second + string(mod10(second)) + third + string(mod10(third)) + barCode[4:5] + barCode[5:19], Error.StarkErrors{}
+}
+
+func DueDate(factor int, reference time.Time) *Date.Date {
+	//	Decode a boleto due date factor
+	//
+	//	Factors count the days since 1997-10-07 and restart at 1000 every 9000 days, as on
+	//	2025-02-22. The cycle whose date is the closest to the reference date is chosen.
+	//
+	//	Parameters (required):
+	//	- factor [int]: due date factor between 1000 and 9999. ex: 1000
+	//	- reference [time.Time]: date used to pick the factor cycle, usually the current date
+	//
+	//	Return:
+	//	- due date, or nil if the factor is 0
+	if factor == 0 {
+		return nil
+	}
+	referenceDays := int(reference.Sub(dueFactorBase).Hours() / 24)
+	cycles := 0
+	for distance(factor+(cycles+1)*dueFactorCycle, referenceDays) < distance(factor+cycles*dueFactorCycle, referenceDays) {
+		cycles++
+	}
+	due := Date.New(dueFactorBase.Year(), dueFactorBase.Month(), dueFactorBase.Day()+factor+cycles*dueFactorCycle)
+	return &due
+}
+
+func boletoCheckDigit(digits string) byte {
+	digit := 11 - mod11(digits, 9)
+	if digit == 0 || digit == 10 || digit == 11 {
+		return '1'
+	}
+	return byte('0' + digit)
+}
+
+func distance(a int, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
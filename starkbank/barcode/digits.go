@@ -0,0 +1,47 @@
+package barcode
+
+import (
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"strings"
+)
+
+func normalize(code string) string {
+	return strings.NewReplacer(" ", "", ".", "", "-", "", "\t", "", "\n", "").Replace(code)
+}
+
+func isDigits(code string) bool {
+	for _, char := range code {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func mod10(digits string) byte {
+	sum := 0
+	weight := 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		product := int(digits[i]-'0') * weight
+		sum += product/10 + product%10
+		weight = 3 - weight
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func mod11(digits string, maxWeight int) int {
+	sum := 0
+	weight := 2
+	for i := len(digits) - 1; i >= 0; i-- {
+		sum += int(digits[i]-'0') * weight
+		weight++
+		if weight > maxWeight {
+			weight = 2
+		}
+	}
+	return sum % 11
+}
+
+func invalid(code string, message string) Error.StarkErrors {
+	return Error.StarkErrors{Errors: []Error.StarkError{{Code: code, Message: message}}}
+}
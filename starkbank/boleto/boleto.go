@@ -2,6 +2,8 @@ package boleto
 
 import (
 	"encoding/json"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -26,12 +28,12 @@ import (
 //	- ZipCode [string]: Payer address zip code. ex: 01311-200
 //
 //	Parameters (optional):
-//	- Due [time.Time, default today + 2 days]: Boleto due date in ISO format. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
+//	- Due [date.Date, default today + 2 days]: Boleto due date in ISO format. ex: date.New(2020, time.March, 10),
 //	- Fine [float64, default 2.0]: Boleto fine for overdue payment in %. ex: 2.5
 //	- Interest [float64, default 1.0]: Boleto monthly interest for overdue payment in %. ex: 5.2
 //	- OverdueLimit [int, default 59]: Limit in days for payment after due date. ex: 7 (max: 59)
-//	- Descriptions [slice of maps, default nil]: List of maps with "text":string and (optional) "amount":int pairs
-//	- Discounts [slice of maps, default nil]: List of maps with "percentage":float64 and "date":time.Time or string pairs
+//	- Descriptions [slice of Boleto.Description structs, default nil]: up to 15 descriptions printed on the Boleto. ex: []Boleto.Description{{Text: "Iron Suit", Amount: 1234}}
+//	- Discounts [slice of Boleto.Discount structs, default nil]: up to 5 discounts with their percentages and dates. ex: []Boleto.Discount{{Percentage: 5, Date: &discountDate}}
 //	- Tags [slice of strings, default nil]: Slice of strings for tagging. ex: []string{"John", "Paul"}
 //	- ReceiverName [string, default nil]: Receiver (Sacador Avalista) full name. ex: "Anthony Edward Stark"
 //	- ReceiverTaxId [string, default nil]: Receiver (Sacador Avalista) tax ID (CPF or CNPJ) with or without formatting. ex: "01234567890" or "20.018.183/0001-80"
@@ -41,7 +43,7 @@ import (
 //	- Fee [int]: Fee charged when Boleto is paid. ex: 200 (= R$ 2.00)
 //	- Line [string]: Generated Boleto line for payment. ex: "34191.09008 63571.277308 71444.640008 5 81960000000062"
 //	- BarCode [string]: Generated Boleto bar-code for payment. ex: "34195819600000000621090063571277307144464000"
-//	- Status [string]: Current Boleto status. ex: "registered" or "paid"
+//	- Status [Status]: Current Boleto status. ex: "created", "registered", "overdue", "paid", "canceled" or "failed"
 //	- TransactionIds [slice of strings]: Ledger transaction ids linked to this boleto. ex: []string{"19827356981273"}
 //  - WorkspaceId [string]: ID of the Workspace that generated this Boleto. ex: "4545454545454545"
 //	- Created [time.Time]: Creation datetime for the Boleto. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
@@ -58,19 +60,19 @@ type Boleto struct {
 	City          string                   `json:",omitempty"`
 	StateCode     string                   `json:",omitempty"`
 	ZipCode       string                   `json:",omitempty"`
-	Due           *time.Time               `json:",omitempty"`
+	Due           *Date.Date               `json:",omitempty"`
 	Fine          float64                  `json:",omitempty"`
 	Interest      float64                  `json:",omitempty"`
 	OverdueLimit  int                      `json:",omitempty"`
-	Descriptions  []map[string]interface{} `json:",omitempty"`
-	Discounts     []map[string]interface{} `json:",omitempty"`
+	Descriptions  []Description            `json:",omitempty"`
+	Discounts     []Discount               `json:",omitempty"`
 	Tags          []string                 `json:",omitempty"`
 	ReceiverName  string                   `json:",omitempty"`
 	ReceiverTaxId string                   `json:",omitempty"`
 	Fee           int                      `json:",omitempty"`
 	Line          string                   `json:",omitempty"`
 	BarCode       string                   `json:",omitempty"`
-	Status        string                   `json:",omitempty"`
+	Status        Status                   `json:",omitempty"`
 	Transactions  []string                 `json:",omitempty"`
 	WorkspaceId   string                   `json:",omitempty"`
 	Created       *time.Time               `json:",omitempty"`
@@ -92,6 +94,15 @@ func Create(boletos []Boleto, user user.User) ([]Boleto, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- Slice of Boleto structs with updated attributes
+	var errors []Error.StarkError
+	for _, boleto := range boletos {
+		errors = append(errors, TaxId.Check(boleto.TaxId, boleto.ReceiverTaxId).Errors...)
+		errors = append(errors, checkTerms(boleto)...)
+	}
+	if errors != nil {
+		return boletos, Error.StarkErrors{Errors: errors}
+	}
+
 	create, err := utils.Multi(resource, boletos, nil, user)
 	unmarshalError := json.Unmarshal(create, &boletos)
 	if unmarshalError != nil {
@@ -148,9 +159,9 @@ func Query(params map[string]interface{}, user user.User) (chan Boleto, chan Err
 	//	Parameters (optional):
 	//	- params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "paid" or "registered"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: boleto.Paid or []boleto.Status{boleto.Paid, boleto.Canceled}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -190,9 +201,9 @@ func Page(params map[string]interface{}, user user.User) ([]Boleto, string, Erro
 	//	- params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "paid" or "registered"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: boleto.Paid or []boleto.Status{boleto.Paid, boleto.Canceled}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
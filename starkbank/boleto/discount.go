@@ -0,0 +1,74 @@
+package boleto
+
+import (
+	"fmt"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+)
+
+//	Boleto.Discount struct
+//
+//	A Discount applied to the Boleto amount when it is paid until its Date.
+//
+//	Parameters (required):
+//	- Percentage [float64]: discount percentage over the Boleto amount, above 0 and up to 100. ex: 2.5
+//	- Date [date.Date]: last date in which the discount is applied, before the Boleto due date. ex: date.New(2020, time.March, 5)
+
+type Discount struct {
+	Percentage float64    `json:",omitempty"`
+	Date       *Date.Date `json:",omitempty"`
+}
+
+//	Boleto.Description struct
+//
+//	A Description line printed on the Boleto.
+//
+//	Parameters (required):
+//	- Text [string]: description text. ex: "Iron Suit"
+//
+//	Parameters (optional):
+//	- Amount [int, default nil]: amount in cents related to the description. ex: 1234 (= R$ 12.34)
+
+type Description struct {
+	Text   string `json:",omitempty"`
+	Amount int    `json:",omitempty"`
+}
+
+const (
+	MaxDiscounts    = 5
+	MaxDescriptions = 15
+)
+
+func checkTerms(boleto Boleto) []Error.StarkError {
+	var errors []Error.StarkError
+	add := func(code string, message string, args ...interface{}) {
+		errors = append(errors, Error.StarkError{Code: code, Message: fmt.Sprintf(message, args...)})
+	}
+
+	if len(boleto.Discounts) > MaxDiscounts {
+		add("invalidDiscount", "Boleto must have up to %d discounts, but it has %d", MaxDiscounts, len(boleto.Discounts))
+	}
+	for _, discount := range boleto.Discounts {
+		if discount.Percentage <= 0 || discount.Percentage > 100 {
+			add("invalidDiscount", "Boleto discount percentage must be above 0 and up to 100, but it is %v", discount.Percentage)
+		}
+		if discount.Date == nil {
+			add("invalidDiscount", "Boleto discount must have a date")
+		} else if boleto.Due != nil && !discount.Date.Before(*boleto.Due) {
+			add("invalidDiscount", "Boleto discount date %s must be before the Boleto due date %s", discount.Date, boleto.Due)
+		}
+	}
+
+	if len(boleto.Descriptions) > MaxDescriptions {
+		add("invalidDescription", "Boleto must have up to %d descriptions, but it has %d", MaxDescriptions, len(boleto.Descriptions))
+	}
+	for _, description := range boleto.Descriptions {
+		if description.Text == "" {
+			add("invalidDescription", "Boleto description must have a text")
+		}
+		if description.Amount < 0 {
+			add("invalidDescription", "Boleto description amount must not be negative, but it is %d", description.Amount)
+		}
+	}
+	return errors
+}
@@ -0,0 +1,48 @@
+package boleto
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	Boleto Status type
+//
+//	Boletos are created and registered at the interbank clearing system, after which they may be
+//	paid, even when overdue. Registration may fail and unpaid Boletos may be canceled with Delete.
+
+type Status string
+
+const (
+	Created    Status = "created"
+	Registered Status = "registered"
+	Overdue    Status = "overdue"
+	Paid       Status = "paid"
+	Canceled   Status = "canceled"
+	Failed     Status = "failed"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"paid"},
+	Transitions: map[string][]string{
+		"created":    {"registered", "failed", "canceled"},
+		"registered": {"paid", "overdue", "canceled"},
+		"overdue":    {"paid", "canceled"},
+		"paid":       {},
+		"canceled":   {},
+		"failed":     {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "paid"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
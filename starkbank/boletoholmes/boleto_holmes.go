@@ -22,7 +22,7 @@ import (
 //
 //	Attributes (return-only):
 //	- Id [string]: Unique id returned when Holmes is created. ex: "5656565656565656"
-//	- Status [string]: Current holmes status. ex: "solving" or "solved"
+//	- Status [Status]: Current holmes status. ex: "solving" or "solved"
 //	- Result [string]: Result of boleto status investigation. ex: "paid" or "cancelled"
 //	- Created [time.Time]: Creation datetime for the holmes. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
 //	- Updated [time.Time]: Latest update datetime for the holmes. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC)
@@ -31,7 +31,7 @@ type BoletoHolmes struct {
 	Id       string     `json:",omitempty"`
 	BoletoId string     `json:",omitempty"`
 	Tags     []string   `json:",omitempty"`
-	Status   string     `json:",omitempty"`
+	Status   Status     `json:",omitempty"`
 	Result   string     `json:",omitempty"`
 	Created  *time.Time `json:",omitempty"`
 	Updated  *time.Time `json:",omitempty"`
@@ -93,11 +93,11 @@ func Query(params map[string]interface{}, user user.User) (chan BoletoHolmes, ch
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "paid" or "registered"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: boletoholmes.Solved or []boletoholmes.Status{boletoholmes.Solved}
 	//		- boletoId [string, default nil]: Filter for holmes that investigate a specific boleto by its ID. ex: "5656565656565656"
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
@@ -136,11 +136,11 @@ func Page(params map[string]interface{}, user user.User) ([]BoletoHolmes, string
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "paid" or "registered"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: boletoholmes.Solved or []boletoholmes.Status{boletoholmes.Solved}
 	//		- boletoId [string, default nil]: Filter for holmes that investigate a specific boleto by its ID. ex: "5656565656565656"
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
@@ -0,0 +1,40 @@
+package boletoholmes
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	BoletoHolmes Status type
+//
+//	A BoletoHolmes is solving until the investigated Boleto status is found. The found status is
+//	informed in its Result.
+
+type Status string
+
+const (
+	Solving Status = "solving"
+	Solved  Status = "solved"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"solved"},
+	Transitions: map[string][]string{
+		"solving": {"solved"},
+		"solved":  {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "solved"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
@@ -2,6 +2,8 @@ package boletopayment
 
 import (
 	"encoding/json"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -24,12 +26,12 @@ import (
 //
 //	Parameters (optional):
 //	- Amount [int, default nil]: Amount to be paid. If nil is informed, the current boleto value will be used. ex: 23456 (= R$ 234.56)
-//	- Scheduled [time.Time, default today]: Payment scheduled date. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
+//	- Scheduled [date.Date, default today]: Payment scheduled date. ex: date.New(2020, time.March, 10),
 //	- Tags [slice of strings, default nil]: Slice of strings for tagging. ex: []string{"John", "Paul"}
 //
 //	Attributes (return-only):
 //	- Id [string]: Unique id returned when payment is created. ex: "5656565656565656"
-//	- Status [string]: Current payment status. ex: "success" or "failed"
+//	- Status [Status]: Current payment status. ex: "created", "processing", "success", "failed" or "canceled"
 //	- Fee [int]: Fee charged when the Boleto payment is created. ex: 200 (= R$ 2.00)
 //	- TransactionIds [slice of strings]: Ledger transaction ids linked to this BoletoPayment. ex: []string{"19827356981273"}
 //	- Created [time.Time]: Creation datetime for the payment. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
@@ -41,9 +43,9 @@ type BoletoPayment struct {
 	TaxId          string     `json:",omitempty"`
 	Description    string     `json:",omitempty"`
 	Amount         int        `json:",omitempty"`
-	Scheduled      string     `json:",omitempty"`
+	Scheduled      *Date.Date `json:",omitempty"`
 	Tags           []string   `json:",omitempty"`
-	Status         string     `json:",omitempty"`
+	Status         Status     `json:",omitempty"`
 	Fee            int        `json:",omitempty"`
 	TransactionIds []string   `json:",omitempty"`
 	Created        *time.Time `json:",omitempty"`
@@ -64,6 +66,14 @@ func Create(payments []BoletoPayment, user user.User) ([]BoletoPayment, Error.St
 	//
 	//	Return:
 	//	- Slice of BoletoPayment structs with updated attributes
+	var errors []Error.StarkError
+	for _, payment := range payments {
+		errors = append(errors, TaxId.Check(payment.TaxId).Errors...)
+	}
+	if errors != nil {
+		return payments, Error.StarkErrors{Errors: errors}
+	}
+
 	create, err := utils.Multi(resource, payments, nil, user)
 	unmarshalError := json.Unmarshal(create, &payments)
 	if unmarshalError != nil {
@@ -119,11 +129,11 @@ func Query(params map[string]interface{}, user user.User) (chan BoletoPayment, c
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: list of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: boletopayment.Success or []boletopayment.Status{boletopayment.Success, boletopayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -161,11 +171,11 @@ func Page(params map[string]interface{}, user user.User) ([]BoletoPayment, strin
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: cursor returned on the previous page function call
 	//		- limit [int, default 100]: maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: list of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: boletopayment.Success or []boletopayment.Status{boletopayment.Success, boletopayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
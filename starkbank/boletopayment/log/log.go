@@ -63,8 +63,8 @@ func Query(params map[string]interface{}, user user.User) (chan Log, chan Error.
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- types [slice of strings, default nil]: Filter retrieved structs by event types. ex: []string{"processing", "success"}
 	//		- paymentIds [slice of strings, default nil]: List of BoletoPayment ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"]
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -104,8 +104,8 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- types [slice of strings, default nil]: Filter retrieved structs by event types. ex: []string{"processing", "success"}
 	//		- paymentIds [slice of strings, default nil]: List of BoletoPayment.Log ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -121,3 +121,29 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	}
 	return boletoPaymentLogs, cursor, err
 }
+
+var _ utils.Log = Log{}
+
+func (l Log) LogId() string {
+	return l.Id
+}
+
+func (l Log) LogType() string {
+	return l.Type
+}
+
+func (l Log) LogCreated() *time.Time {
+	return l.Created
+}
+
+func (l Log) EntityId() string {
+	return l.Payment.Id
+}
+
+func (l Log) EntityStatus() string {
+	return string(l.Payment.Status)
+}
+
+func (l Log) LogErrors() []string {
+	return l.Errors
+}
@@ -0,0 +1,294 @@
+package brcode
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"strconv"
+	"strings"
+)
+
+//	Pix BR Code struct
+//
+//	Fields decoded from a BR Code, the EMV QR Code string used by Pix, such as the Brcode
+//	attribute of Invoice, CorporateInvoice, DynamicBrcode and InvoicePullSubscription.
+//
+//	Attributes:
+//	- PayloadFormat [string]: payload format indicator. ex: "01"
+//	- InitiationMethod [string]: point of initiation method: "11" for reusable codes, "12" for single-use codes or empty if omitted. ex: "12"
+//	- PixKey [string]: Pix key of the receiver of static codes. ex: "+5511989898989" or "tony@starkbank.com"
+//	- Url [string]: location of the payload of dynamic codes, without the scheme. ex: "api.starkbank.com/v2/brcode/5656565656565656"
+//	- Description [string]: additional information shown to the payer in static codes. ex: "Monthly fee"
+//	- MerchantCategoryCode [string]: merchant category code. ex: "0000"
+//	- Currency [string]: ISO 4217 numeric currency code. ex: "986"
+//	- Amount [int]: amount in cents. 0 if the payer chooses the amount. ex: 1234 (= R$ 12.34)
+//	- Country [string]: ISO 3166-1 alpha-2 country code. ex: "BR"
+//	- MerchantName [string]: receiver name. ex: "Stark Bank S.A."
+//	- MerchantCity [string]: receiver city. ex: "Sao Paulo"
+//	- PostalCode [string]: receiver postal code. ex: "01414000"
+//	- TxId [string]: transaction identifier. "***" when not informed. ex: "invoice123"
+//	- Recurrence [Recurrence struct pointer]: Pix Automático recurrence template. nil if the code has no recurrence
+//	- Crc [string]: CRC16 checksum. ex: "1D3D"
+
+type Brcode struct {
+	PayloadFormat        string
+	InitiationMethod     string
+	PixKey               string
+	Url                  string
+	Description          string
+	MerchantCategoryCode string
+	Currency             string
+	Amount               int
+	Country              string
+	MerchantName         string
+	MerchantCity         string
+	PostalCode           string
+	TxId                 string
+	Recurrence           *Recurrence
+	Crc                  string
+}
+
+//	Pix Automático Recurrence struct
+//
+//	Recurrence template of BR Codes that authorize Pix Automático debits, optionally combined
+//	with an immediate payment.
+//
+//	Attributes:
+//	- Url [string]: location of the recurrence payload, without the scheme. ex: "api.starkbank.com/v2/recurrence/5656565656565656"
+
+type Recurrence struct {
+	Url string
+}
+
+const pixGui = "br.gov.bcb.pix"
+
+const (
+	payloadFormatId    = "00"
+	initiationMethodId = "01"
+	merchantAccountId  = "26"
+	categoryCodeId     = "52"
+	currencyId         = "53"
+	amountId           = "54"
+	countryId          = "58"
+	merchantNameId     = "59"
+	merchantCityId     = "60"
+	postalCodeId       = "61"
+	additionalDataId   = "62"
+	crcId              = "63"
+	recurrenceId       = "80"
+	guiId              = "00"
+	keyId              = "01"
+	descriptionId      = "02"
+	urlId              = "25"
+	txIdId             = "05"
+)
+
+type field struct {
+	id    string
+	value string
+}
+
+func Decode(code string) (Brcode, Error.StarkErrors) {
+	//	Decode a BR Code
+	//
+	//	Validate the TLV structure, the mandatory fields and the CRC16 checksum of a BR Code and decode its fields.
+	//
+	//	Parameters (required):
+	//	- code [string]: BR Code string. ex: "00020101021226890014br.gov.bcb.pix2567..."
+	//
+	//	Return:
+	//	- Brcode struct
+	fields, err := parse(code)
+	if err.Errors != nil {
+		return Brcode{}, err
+	}
+	if len(fields) < 2 || fields[0].id != payloadFormatId || fields[len(fields)-1].id != crcId {
+		return Brcode{}, invalid("BR Code must start with the payload format indicator and end with the CRC16 checksum")
+	}
+
+	crc := fields[len(fields)-1].value
+	if len(crc) != 4 {
+		return Brcode{}, invalid(fmt.Sprintf("BR Code CRC16 checksum must have 4 characters, but it is %q", crc))
+	}
+	expected := Crc16(code[:len(code)-len(crc)])
+	if !strings.EqualFold(crc, expected) {
+		return Brcode{}, invalid(fmt.Sprintf("BR Code CRC16 checksum should be %s, but it is %s", expected, crc))
+	}
+
+	var brcode Brcode
+	var accounts []string
+	for _, f := range fields {
+		switch f.id {
+		case payloadFormatId:
+			brcode.PayloadFormat = f.value
+		case initiationMethodId:
+			brcode.InitiationMethod = f.value
+		case categoryCodeId:
+			brcode.MerchantCategoryCode = f.value
+		case currencyId:
+			brcode.Currency = f.value
+		case amountId:
+			brcode.Amount, err = parseAmount(f.value)
+		case countryId:
+			brcode.Country = f.value
+		case merchantNameId:
+			brcode.MerchantName = f.value
+		case merchantCityId:
+			brcode.MerchantCity = f.value
+		case postalCodeId:
+			brcode.PostalCode = f.value
+		case crcId:
+			brcode.Crc = f.value
+		case additionalDataId:
+			brcode.TxId, err = subfield(f, txIdId)
+		case recurrenceId:
+			brcode.Recurrence, err = parseRecurrence(f)
+		default:
+			if f.id >= merchantAccountId && f.id <= "51" {
+				accounts = append(accounts, f.value)
+			}
+		}
+		if err.Errors != nil {
+			return Brcode{}, err
+		}
+	}
+
+	err = brcode.parseAccount(accounts)
+	if err.Errors != nil {
+		return Brcode{}, err
+	}
+	return brcode, brcode.validate()
+}
+
+func (b Brcode) IsDynamic() bool {
+	//	Return true if the BR Code payload is fetched from its Url, false if all data is in the code itself
+	return b.Url != ""
+}
+
+func (b *Brcode) parseAccount(accounts []string) Error.StarkErrors {
+	for _, account := range accounts {
+		subfields, err := parse(account)
+		if err.Errors != nil {
+			return err
+		}
+		values := map[string]string{}
+		for _, f := range subfields {
+			values[f.id] = f.value
+		}
+		if !strings.EqualFold(values[guiId], pixGui) {
+			continue
+		}
+		b.PixKey = values[keyId]
+		b.Description = values[descriptionId]
+		b.Url = values[urlId]
+		return Error.StarkErrors{}
+	}
+	if b.Recurrence != nil {
+		return Error.StarkErrors{}
+	}
+	return invalid(fmt.Sprintf("BR Code must have a merchant account information with the %s GUI", pixGui))
+}
+
+func (b Brcode) validate() Error.StarkErrors {
+	var errors []Error.StarkError
+	required := []struct {
+		name  string
+		value string
+	}{
+		{"payload format indicator", b.PayloadFormat},
+		{"currency", b.Currency},
+		{"country", b.Country},
+		{"merchant name", b.MerchantName},
+		{"merchant city", b.MerchantCity},
+	}
+	for _, r := range required {
+		if r.value == "" {
+			errors = append(errors, Error.StarkError{Code: "invalidBrcode", Message: fmt.Sprintf("BR Code must have a %s", r.name)})
+		}
+	}
+	if b.PayloadFormat != "" && b.PayloadFormat != "01" {
+		errors = append(errors, Error.StarkError{Code: "invalidBrcode", Message: fmt.Sprintf("BR Code payload format indicator must be 01, but it is %s", b.PayloadFormat)})
+	}
+	if b.InitiationMethod != "" && b.InitiationMethod != "11" && b.InitiationMethod != "12" {
+		errors = append(errors, Error.StarkError{Code: "invalidBrcode", Message: fmt.Sprintf("BR Code point of initiation method must be 11 or 12, but it is %s", b.InitiationMethod)})
+	}
+	if b.PixKey == "" && b.Url == "" && b.Recurrence == nil {
+		errors = append(errors, Error.StarkError{Code: "invalidBrcode", Message: "BR Code must have a Pix key or an url"})
+	}
+	return Error.StarkErrors{Errors: errors}
+}
+
+func parse(code string) ([]field, Error.StarkErrors) {
+	var fields []field
+	runes := []rune(code)
+	for position := 0; position < len(runes); {
+		if position+4 > len(runes) {
+			return nil, invalid(fmt.Sprintf("BR Code field at position %d is truncated", position))
+		}
+		id := string(runes[position : position+2])
+		length, parseError := strconv.Atoi(string(runes[position+2 : position+4]))
+		if parseError != nil || !isNumeric(id) || length < 0 {
+			return nil, invalid(fmt.Sprintf("BR Code field at position %d has an invalid id or length", position))
+		}
+		position += 4
+		if position+length > len(runes) {
+			return nil, invalid(fmt.Sprintf("BR Code field %s is longer than the code", id))
+		}
+		fields = append(fields, field{id: id, value: string(runes[position : position+length])})
+		position += length
+	}
+	return fields, Error.StarkErrors{}
+}
+
+func subfield(f field, id string) (string, Error.StarkErrors) {
+	subfields, err := parse(f.value)
+	if err.Errors != nil {
+		return "", err
+	}
+	for _, sub := range subfields {
+		if sub.id == id {
+			return sub.value, Error.StarkErrors{}
+		}
+	}
+	return "", Error.StarkErrors{}
+}
+
+func parseRecurrence(f field) (*Recurrence, Error.StarkErrors) {
+	gui, err := subfield(f, guiId)
+	if err.Errors != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(gui, pixGui) {
+		return nil, Error.StarkErrors{}
+	}
+	url, err := subfield(f, urlId)
+	if err.Errors != nil {
+		return nil, err
+	}
+	return &Recurrence{Url: url}, Error.StarkErrors{}
+}
+
+func parseAmount(value string) (int, Error.StarkErrors) {
+	parts := strings.Split(value, ".")
+	if len(parts) > 2 || parts[0] == "" || !isNumeric(parts[0]) || (len(parts) == 2 && (len(parts[1]) > 2 || !isNumeric(parts[1]))) {
+		return 0, invalid(fmt.Sprintf("BR Code amount %q is not a valid decimal amount", value))
+	}
+	reais, _ := strconv.Atoi(parts[0])
+	cents := 0
+	if len(parts) == 2 {
+		cents, _ = strconv.Atoi((parts[1] + "00")[:2])
+	}
+	return reais*100 + cents, Error.StarkErrors{}
+}
+
+func isNumeric(value string) bool {
+	for _, char := range value {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func invalid(message string) Error.StarkErrors {
+	return Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidBrcode", Message: message}}}
+}
@@ -0,0 +1,29 @@
+package brcode
+
+import (
+	"fmt"
+)
+
+func Crc16(payload string) string {
+	//	Compute the CRC16 checksum of a BR Code
+	//
+	//	Uses the CRC-16/CCITT-FALSE algorithm (polynomial 0x1021, initial value 0xFFFF) required by the BR Code specification.
+	//
+	//	Parameters (required):
+	//	- payload [string]: BR Code content up to and including the checksum id and length "6304"
+	//
+	//	Return:
+	//	- 4-character uppercase hexadecimal checksum. ex: "1D3D"
+	crc := uint16(0xFFFF)
+	for _, b := range []byte(payload) {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return fmt.Sprintf("%04X", crc)
+}
@@ -0,0 +1,137 @@
+package brcode
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"strings"
+)
+
+var unaccented = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a", "é", "e", "ê", "e", "è", "e", "í", "i", "ì", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ò", "o", "ö", "o", "ú", "u", "ù", "u", "ü", "u", "ç", "c", "ñ", "n",
+	"Á", "A", "À", "A", "Â", "A", "Ã", "A", "Ä", "A", "É", "E", "Ê", "E", "È", "E", "Í", "I", "Ì", "I",
+	"Ó", "O", "Ô", "O", "Õ", "O", "Ò", "O", "Ö", "O", "Ú", "U", "Ù", "U", "Ü", "U", "Ç", "C", "Ñ", "N",
+)
+
+func Generate(brcode Brcode) (string, Error.StarkErrors) {
+	//	Generate a static Pix BR Code
+	//
+	//	Build a static BR Code locally, without creating an Invoice or DynamicBrcode in the API.
+	//	Accents are removed from the merchant name, city and description, and other non-ASCII
+	//	characters are rejected, since field lengths and the CRC are computed over ASCII bytes.
+	//
+	//	Parameters (required):
+	//	- brcode [Brcode struct]: fields of the BR Code. PixKey, MerchantName and MerchantCity are required
+	//		- PixKey [string]: Pix key of the receiver. ex: "+5511989898989" or "tony@starkbank.com"
+	//		- MerchantName [string]: receiver name, up to 25 characters. ex: "Stark Bank S.A."
+	//		- MerchantCity [string]: receiver city, up to 15 characters. ex: "Sao Paulo"
+	//		- Amount [int, default 0]: amount in cents. If 0, the payer chooses the amount. ex: 1234 (= R$ 12.34)
+	//		- TxId [string, default "***"]: transaction identifier with up to 25 letters and digits. ex: "invoice123"
+	//		- Description [string, default ""]: additional information shown to the payer. ex: "Monthly fee"
+	//		- PostalCode [string, default ""]: receiver postal code. ex: "01414000"
+	//		- InitiationMethod [string, default ""]: "11" for reusable codes or "12" for single-use codes. ex: "11"
+	//
+	//	Return:
+	//	- BR Code string
+	brcode.MerchantName = unaccented.Replace(brcode.MerchantName)
+	brcode.MerchantCity = unaccented.Replace(brcode.MerchantCity)
+	brcode.Description = unaccented.Replace(brcode.Description)
+	if brcode.TxId == "" {
+		brcode.TxId = "***"
+	}
+	err := validateStatic(brcode)
+	if err.Errors != nil {
+		return "", err
+	}
+
+	payload := tlv(payloadFormatId, "01")
+	if brcode.InitiationMethod != "" {
+		payload += tlv(initiationMethodId, brcode.InitiationMethod)
+	}
+	payload += tlv(merchantAccountId, accountInformation(brcode)) +
+		tlv(categoryCodeId, "0000") +
+		tlv(currencyId, "986")
+	if brcode.Amount > 0 {
+		payload += tlv(amountId, fmt.Sprintf("%d.%02d", brcode.Amount/100, brcode.Amount%100))
+	}
+	payload += tlv(countryId, "BR") +
+		tlv(merchantNameId, brcode.MerchantName) +
+		tlv(merchantCityId, brcode.MerchantCity)
+	if brcode.PostalCode != "" {
+		payload += tlv(postalCodeId, brcode.PostalCode)
+	}
+	payload += tlv(additionalDataId, tlv(txIdId, brcode.TxId)) + crcId + "04"
+	return payload + Crc16(payload), Error.StarkErrors{}
+}
+
+func validateStatic(brcode Brcode) Error.StarkErrors {
+	var errors []Error.StarkError
+	add := func(message string, args ...interface{}) {
+		errors = append(errors, Error.StarkError{Code: "invalidBrcode", Message: fmt.Sprintf(message, args...)})
+	}
+
+	if brcode.PixKey == "" {
+		add("BR Code must have a Pix key")
+	}
+	if brcode.Url != "" {
+		add("Only static BR Codes can be generated, but an url was informed")
+	}
+	if length := len([]rune(brcode.MerchantName)); length == 0 || length > 25 {
+		add("BR Code merchant name must have from 1 to 25 characters, but %q has %d", brcode.MerchantName, length)
+	}
+	if length := len([]rune(brcode.MerchantCity)); length == 0 || length > 15 {
+		add("BR Code merchant city must have from 1 to 15 characters, but %q has %d", brcode.MerchantCity, length)
+	}
+	if brcode.Amount < 0 {
+		add("BR Code amount must not be negative, but it is %d", brcode.Amount)
+	}
+	if brcode.TxId != "***" && (len(brcode.TxId) > 25 || !isAlphanumeric(brcode.TxId)) {
+		add("BR Code txid must have up to 25 letters and digits, but it is %q", brcode.TxId)
+	}
+	if brcode.InitiationMethod != "" && brcode.InitiationMethod != "11" && brcode.InitiationMethod != "12" {
+		add("BR Code point of initiation method must be 11 or 12, but it is %s", brcode.InitiationMethod)
+	}
+	if brcode.PostalCode != "" && (len(brcode.PostalCode) > 99 || !isNumeric(brcode.PostalCode)) {
+		add("BR Code postal code must have only digits, but it is %q", brcode.PostalCode)
+	}
+	for _, field := range [][2]string{{"Pix key", brcode.PixKey}, {"merchant name", brcode.MerchantName}, {"merchant city", brcode.MerchantCity}, {"description", brcode.Description}} {
+		if !isAscii(field[1]) {
+			add("BR Code %s must have only ASCII characters, but it is %q", field[0], field[1])
+		}
+	}
+
+	if len([]rune(accountInformation(brcode))) > 99 {
+		add("BR Code Pix key and description are too long: they must have at most %d characters together", 99-len(tlv(guiId, pixGui))-8)
+	}
+	return Error.StarkErrors{Errors: errors}
+}
+
+func accountInformation(brcode Brcode) string {
+	account := tlv(guiId, pixGui) + tlv(keyId, brcode.PixKey)
+	if brcode.Description != "" {
+		account += tlv(descriptionId, brcode.Description)
+	}
+	return account
+}
+
+func tlv(id string, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len([]rune(value)), value)
+}
+
+func isAscii(value string) bool {
+	for _, char := range value {
+		if char > '~' || char < ' ' {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlphanumeric(value string) bool {
+	for _, char := range value {
+		if !(char >= '0' && char <= '9') && !(char >= 'a' && char <= 'z') && !(char >= 'A' && char <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
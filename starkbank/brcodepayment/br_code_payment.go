@@ -3,6 +3,7 @@ package brcodepayment
 import (
 	"encoding/json"
 	"github.com/starkbank/sdk-go/starkbank/brcodepayment/rules"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -25,13 +26,13 @@ import (
 //
 //	Parameters (optional):
 //	- Scheduled [time.Time, default now]: Payment scheduled date or datetime. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
-//	- Rules [slice of BrcodePayment.Rules, default nil]: slice of BrcodePayment.Rule structs for modifying transfer behavior. ex: []rule.Rule{{Key: "resendingLimit", Value: 5}},
+//	- Rules [slice of BrcodePayment.Rules, default nil]: slice of BrcodePayment.Rule structs for modifying transfer behavior. ex: []rules.Rule{rules.ResendingLimit(5)},
 //	- Tags [slice of strings, default nil]: Slice of strings for tagging. ex: []string{"John", "Paul"}
 //
 //	Attributes (return-only):
 //	- Id [string]: Unique id returned when payment is created. ex: "5656565656565656"
 //	- Name [string]: Receiver name. ex: "Jon Snow"
-//	- Status [string]: Current payment status. ex: "success" or "failed"
+//	- Status [Status]: Current payment status. ex: "created", "processing", "success", "failed" or "canceled"
 //	- Type [string]: Brcode type. ex: "static" or "dynamic"
 //	- TransactionIds [slice of strings]: Ledger transaction ids linked to this payment. ex: []string{"19827356981273"}
 //	- Fee [int]: Fee charged by this brcode payment. ex: 50 (= R$ 0.50)
@@ -48,7 +49,7 @@ type BrcodePayment struct {
 	Rules          []rules.Rule `json:",omitempty"`
 	Tags           []string     `json:",omitempty"`
 	Name           string       `json:",omitempty"`
-	Status         string       `json:",omitempty"`
+	Status         Status       `json:",omitempty"`
 	Type           string       `json:",omitempty"`
 	TransactionIds []string     `json:",omitempty"`
 	Fee            int          `json:",omitempty"`
@@ -71,6 +72,15 @@ func Create(payments []BrcodePayment, user user.User) ([]BrcodePayment, Error.St
 	//
 	//	Return:
 	//	- Slice of BrcodePayment structs with updated attributes
+	var errors []Error.StarkError
+	for _, payment := range payments {
+		errors = append(errors, TaxId.Check(payment.TaxId).Errors...)
+		errors = append(errors, rules.Check(payment.Rules).Errors...)
+	}
+	if errors != nil {
+		return payments, Error.StarkErrors{Errors: errors}
+	}
+
 	create, err := utils.Multi(resource, payments, nil, user)
 	unmarshalError := json.Unmarshal(create, &payments)
 	if unmarshalError != nil {
@@ -127,11 +137,11 @@ func Query(params map[string]interface{}, user user.User) (chan BrcodePayment, c
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: brcodepayment.Success or []brcodepayment.Status{brcodepayment.Success, brcodepayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -169,11 +179,11 @@ func Page(params map[string]interface{}, user user.User) ([]BrcodePayment, strin
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: brcodepayment.Success or []brcodepayment.Status{brcodepayment.Success, brcodepayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -1,14 +1,54 @@
 package rules
 
+import (
+	"fmt"
+	"github.com/starkbank/sdk-go/starkbank/utils"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+)
+
 //	BrcodePayment.Rule struct
 //
 //	The BrcodePayment.Rule struct modifies the behavior of BrcodePayment structs when passed as an argument upon their creation.
+//	Use the constructors, such as ResendingLimit, to build rules that are checked before the BrcodePayments are sent to the API.
 //
 //	Attributes (return-only):
 //	- Key [string]: Rule to be customized, describes what BrcodePayment behavior will be altered. ex: "resendingLimit"
-//	- Value [int]: Value of the rule. ex: 5
+//	- Value [int, list of string or any other JSON value]: Value of the rule. Rules without a constructor can be built with any value the API accepts for their Key. ex: 5 or rules.Rule{Key: "allowedTaxIds", Value: []string{"012.345.678-90"}}
 
 type Rule struct {
-	Key   string `json:",omitempty"`
-	Value int    `json:",omitempty"`
+	Key   string      `json:",omitempty"`
+	Value interface{} `json:",omitempty"`
+}
+
+const ResendingLimitKey = "resendingLimit"
+
+func ResendingLimit(limit int) Rule {
+	//	Build a rule with the number of times the BrcodePayment will be resent if it fails. ex: rules.ResendingLimit(5)
+	return Rule{Key: ResendingLimitKey, Value: limit}
+}
+
+func (r Rule) ResendingLimit() (int, bool) {
+	//	Get the resending limit of a "resendingLimit" rule. The second return is false for other rules
+	limit, ok := utils.ToInt(r.Value)
+	return limit, ok && r.Key == ResendingLimitKey
+}
+
+func Check(rules []Rule) Error.StarkErrors {
+	//	Validate the value of each rule with a constructor, returning an "invalidRule" error for each invalid one.
+	//	Rules with other keys are sent to the API unchanged.
+	return utils.CheckRules(len(rules), func(index int) string {
+		rule := rules[index]
+		switch rule.Key {
+		case ResendingLimitKey:
+			if limit, ok := utils.ToInt(rule.Value); !ok || limit < 0 {
+				return fmt.Sprintf("BrcodePayment rule %q must be a non-negative integer, but it is %v", rule.Key, rule.Value)
+			}
+		}
+		return ""
+	})
+}
+
+func (r *Rule) UnmarshalJSON(data []byte) (err error) {
+	r.Key, r.Value, err = utils.UnmarshalRule(data, map[string]interface{}{ResendingLimitKey: 0})
+	return err
 }
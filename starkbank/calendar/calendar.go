@@ -0,0 +1,274 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+//	Calendar struct
+//
+//	A Calendar tells which days are business days for the Brazilian banking system, so that
+//	scheduled Transfers, BoletoPayments, DarfPayments and Invoice due dates can be predicted
+//	before the Stark Bank API moves them to the next business day. National banking holidays
+//	are built in, including Carnival, Good Friday and Corpus Christi, which are computed from
+//	Easter, and local holidays may be added for the cities where your payments are made.
+//	Midnights in UTC are taken as dates, such as time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC),
+//	while other datetimes, such as date.New(2024, time.February, 9).Time(), are converted to
+//	the Location before their day is used.
+//	The zero value is ready to use.
+//
+//	Parameters (optional):
+//	- Location [*time.Location, default America/Sao_Paulo]: time zone used to tell the calendar day of a datetime
+//	- TedCutoff [time.Duration, default 16 hours]: time of the day after which TEDs are only settled on the next business day. ex: 15*time.Hour + 30*time.Minute
+
+type Calendar struct {
+	Location  *time.Location
+	TedCutoff time.Duration
+	mutex     sync.RWMutex
+	dates     map[string]string
+	yearly    map[string]string
+}
+
+//	Holiday struct
+//
+//	Attributes:
+//	- Name [string]: holiday name. ex: "Tiradentes"
+//	- Date [time.Time]: holiday date at midnight in the Calendar Location. ex: time.Date(2024, 4, 21, 0, 0, 0, 0, location)
+//	- Local [bool]: true if the holiday was added to the Calendar and is not a national banking holiday
+
+type Holiday struct {
+	Name  string
+	Date  time.Time
+	Local bool
+}
+
+const (
+	Pix = "pix"
+	Ted = "ted"
+)
+
+const defaultTedCutoff = 16 * time.Hour
+
+var saoPaulo = loadSaoPaulo()
+
+func loadSaoPaulo() *time.Location {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		return time.FixedZone("-03", -3*60*60)
+	}
+	return location
+}
+
+func (c *Calendar) AddHoliday(date time.Time, name string) {
+	//	Add a local holiday on a single date
+	//
+	//	Parameters (required):
+	//	- date [time.Time]: holiday date. ex: time.Date(2024, 7, 9, 0, 0, 0, 0, time.UTC)
+	//	- name [string]: holiday name. ex: "Founding of the city"
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.dates == nil {
+		c.dates = map[string]string{}
+	}
+	c.dates[c.day(date).Format("2006-01-02")] = name
+}
+
+func (c *Calendar) AddYearlyHoliday(month time.Month, day int, name string) {
+	//	Add a local holiday repeated every year on the same day
+	//
+	//	Parameters (required):
+	//	- month [time.Month]: holiday month. ex: time.January
+	//	- day [int]: holiday day of the month. ex: 25
+	//	- name [string]: holiday name. ex: "Sao Paulo anniversary"
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.yearly == nil {
+		c.yearly = map[string]string{}
+	}
+	c.yearly[monthDay(month, day)] = name
+}
+
+func (c *Calendar) Holidays(year int) []Holiday {
+	//	List the national and local holidays of a year
+	//
+	//	Parameters (required):
+	//	- year [int]: year of the holidays. ex: 2024
+	//
+	//	Return:
+	//	- slice of Holiday structs sorted by date
+	holidays := nationalHolidays(year, c.location())
+
+	c.mutex.RLock()
+	for key, name := range c.yearly {
+		date, err := time.ParseInLocation("2006-01-02", fmt.Sprintf("%04d-%s", year, key), c.location())
+		if err == nil {
+			holidays = append(holidays, Holiday{Name: name, Date: date, Local: true})
+		}
+	}
+	for key, name := range c.dates {
+		date, err := time.ParseInLocation("2006-01-02", key, c.location())
+		if err == nil && date.Year() == year {
+			holidays = append(holidays, Holiday{Name: name, Date: date, Local: true})
+		}
+	}
+	c.mutex.RUnlock()
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+		return holidays[i].Date.Before(holidays[j].Date)
+	})
+	return holidays
+}
+
+func (c *Calendar) Holiday(date time.Time) (Holiday, bool) {
+	//	Get the holiday on a date, if any
+	//
+	//	Parameters (required):
+	//	- date [time.Time]: date to be checked. ex: time.Date(2024, 2, 13, 0, 0, 0, 0, time.UTC)
+	//
+	//	Return:
+	//	- Holiday struct
+	//	- true if the date is a national or local holiday
+	day := c.day(date)
+	for _, holiday := range nationalHolidays(day.Year(), c.location()) {
+		if holiday.Date.Equal(day) {
+			return holiday, true
+		}
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if name, ok := c.dates[day.Format("2006-01-02")]; ok {
+		return Holiday{Name: name, Date: day, Local: true}, true
+	}
+	if name, ok := c.yearly[monthDay(day.Month(), day.Day())]; ok {
+		return Holiday{Name: name, Date: day, Local: true}, true
+	}
+	return Holiday{}, false
+}
+
+func (c *Calendar) Day(date time.Time) time.Time {
+	//	Get the calendar day of a date or datetime at midnight in the Calendar Location
+	return c.day(date)
+}
+
+func (c *Calendar) IsBusinessDay(date time.Time) bool {
+	//	Return true if the date is neither a weekend nor a holiday
+	day := c.day(date)
+	if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+		return false
+	}
+	_, isHoliday := c.Holiday(day)
+	return !isHoliday
+}
+
+func (c *Calendar) NextBusinessDay(date time.Time) time.Time {
+	//	Get the first business day after a date
+	//
+	//	Parameters (required):
+	//	- date [time.Time]: reference date. ex: time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC)
+	//
+	//	Return:
+	//	- business day at midnight in the Calendar Location. ex: 2024-02-14 (Ash Wednesday) for the Friday before Carnival
+	return c.AddBusinessDays(date, 1)
+}
+
+func (c *Calendar) PreviousBusinessDay(date time.Time) time.Time {
+	//	Get the last business day before a date
+	return c.AddBusinessDays(date, -1)
+}
+
+func (c *Calendar) Adjust(date time.Time) time.Time {
+	//	Get the date itself if it is a business day or the next business day otherwise,
+	//	which is how the Stark Bank API moves scheduled dates that fall on weekends or holidays
+	day := c.day(date)
+	if c.IsBusinessDay(day) {
+		return day
+	}
+	return c.NextBusinessDay(day)
+}
+
+func (c *Calendar) AddBusinessDays(date time.Time, days int) time.Time {
+	//	Add a number of business days to a date
+	//
+	//	Parameters (required):
+	//	- date [time.Time]: reference date. It does not need to be a business day. ex: time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC)
+	//	- days [int]: number of business days to be added. Negative numbers go back in time. ex: 3
+	//
+	//	Return:
+	//	- business day at midnight in the Calendar Location. If days is 0, the date itself
+	day := c.day(date)
+	step := 1
+	if days < 0 {
+		step, days = -1, -days
+	}
+	for days > 0 {
+		day = day.AddDate(0, 0, step)
+		if c.IsBusinessDay(day) {
+			days--
+		}
+	}
+	return day
+}
+
+func (c *Calendar) BusinessDaysBetween(start time.Time, end time.Time) int {
+	//	Count the business days after start up to and including end. Negative if end is before start
+	from, to := c.day(start), c.day(end)
+	sign := 1
+	if to.Before(from) {
+		from, to, sign = to, from, -1
+	}
+	count := 0
+	for day := from.AddDate(0, 0, 1); !day.After(to); day = day.AddDate(0, 0, 1) {
+		if c.IsBusinessDay(day) {
+			count++
+		}
+	}
+	return sign * count
+}
+
+func (c *Calendar) SettlementDate(rail string, at time.Time) time.Time {
+	//	Get the date when a payment requested at a given time is settled
+	//
+	//	Pix payments run 24/7 and are settled on the day they are requested. TEDs requested
+	//	on a weekend, on a holiday or after the TedCutoff are settled on the next business day.
+	//
+	//	Parameters (required):
+	//	- rail [string]: payment rail: calendar.Pix or calendar.Ted. ex: calendar.Ted
+	//	- at [time.Time]: datetime when the payment is requested or scheduled. ex: time.Now()
+	//
+	//	Return:
+	//	- settlement date at midnight in the Calendar Location
+	local := at.In(c.location())
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location())
+	if rail != Ted {
+		return day
+	}
+	cutoff := c.TedCutoff
+	if cutoff == 0 {
+		cutoff = defaultTedCutoff
+	}
+	if !c.IsBusinessDay(day) || local.Sub(day) >= cutoff {
+		return c.NextBusinessDay(day)
+	}
+	return day
+}
+
+func (c *Calendar) location() *time.Location {
+	if c.Location == nil {
+		return saoPaulo
+	}
+	return c.Location
+}
+
+func (c *Calendar) day(date time.Time) time.Time {
+	if date.Location() != time.UTC || date.Hour() != 0 || date.Minute() != 0 || date.Second() != 0 || date.Nanosecond() != 0 {
+		date = date.In(c.location())
+	}
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, c.location())
+}
+
+func monthDay(month time.Month, day int) string {
+	return time.Date(2000, month, day, 0, 0, 0, 0, time.UTC).Format("01-02")
+}
@@ -0,0 +1,48 @@
+package calendar
+
+import (
+	"time"
+)
+
+func nationalHolidays(year int, location *time.Location) []Holiday {
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, location)
+	}
+	easter := Easter(year, location)
+
+	holidays := []Holiday{
+		{Name: "Confraternização Universal", Date: date(time.January, 1)},
+		{Name: "Carnaval", Date: easter.AddDate(0, 0, -48)},
+		{Name: "Carnaval", Date: easter.AddDate(0, 0, -47)},
+		{Name: "Sexta-feira Santa", Date: easter.AddDate(0, 0, -2)},
+		{Name: "Tiradentes", Date: date(time.April, 21)},
+		{Name: "Dia do Trabalho", Date: date(time.May, 1)},
+		{Name: "Corpus Christi", Date: easter.AddDate(0, 0, 60)},
+		{Name: "Independência do Brasil", Date: date(time.September, 7)},
+		{Name: "Nossa Senhora Aparecida", Date: date(time.October, 12)},
+		{Name: "Finados", Date: date(time.November, 2)},
+		{Name: "Proclamação da República", Date: date(time.November, 15)},
+	}
+	if year >= 2024 {
+		holidays = append(holidays, Holiday{Name: "Dia Nacional de Zumbi e da Consciência Negra", Date: date(time.November, 20)})
+	}
+	return append(holidays, Holiday{Name: "Natal", Date: date(time.December, 25)})
+}
+
+func Easter(year int, location *time.Location) time.Time {
+	//	Compute the Easter Sunday of a year in the Gregorian calendar
+	//
+	//	Parameters (required):
+	//	- year [int]: year of the Easter Sunday. ex: 2024
+	//	- location [*time.Location]: time zone of the returned date. ex: time.UTC
+	//
+	//	Return:
+	//	- Easter Sunday at midnight. ex: 2024-03-31
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := (19*a + b - b/4 - (b-(b+8)/25+1)/3 + 15) % 30
+	e := (32 + 2*(b%4) + 2*(c/4) - d - c%4) % 7
+	f := d + e - 7*((a+11*d+22*e)/451) + 114
+	return time.Date(year, time.Month(f/31), f%31+1, 0, 0, 0, 0, location)
+}
@@ -0,0 +1,228 @@
+package charge
+
+import (
+	"fmt"
+	Boleto "github.com/starkbank/sdk-go/starkbank/boleto"
+	Calendar "github.com/starkbank/sdk-go/starkbank/calendar"
+	Invoice "github.com/starkbank/sdk-go/starkbank/invoice"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"math"
+	"sort"
+	"time"
+)
+
+//	Charge Terms struct
+//
+//	Terms hold the values used to compute the amount due on a payment date. They are usually
+//	built from an Invoice or a Boleto by the Calculator, but may be filled directly to quote
+//	charges that were not created yet.
+//
+//	Parameters (required):
+//	- NominalAmount [int]: charge value in cents before fines, interest and discounts. ex: 400000 (= R$ 4000.00)
+//	- Due [time.Time]: charge due date. ex: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+//
+//	Parameters (optional):
+//	- Fine [float64, default 0]: fine for overdue payment in %. ex: 2.5
+//	- Interest [float64, default 0]: monthly interest for overdue payment in %, charged pro rata per calendar day late. ex: 1.0
+//	- Discounts [slice of Discount structs, default nil]: discounts for payments made up to each date
+
+type Terms struct {
+	NominalAmount int
+	Due           time.Time
+	Fine          float64
+	Interest      float64
+	Discounts     []Discount
+}
+
+//	Charge Discount struct
+//
+//	Parameters (required):
+//	- Percentage [float64]: discount over the nominal amount in %. ex: 5.0
+//	- Due [time.Time]: last day when the discount applies. ex: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+
+type Discount struct {
+	Percentage float64
+	Due        time.Time
+}
+
+//	Charge struct
+//
+//	Amounts due on a payment date, with the same breakdown returned by the Stark Bank API.
+//
+//	Attributes (return-only):
+//	- Amount [int]: amount due in cents. ex: 408000 (= R$ 4080.00)
+//	- NominalAmount [int]: charge value in cents before fines, interest and discounts. ex: 400000
+//	- FineAmount [int]: fine value calculated over the nominal amount. ex: 8000
+//	- InterestAmount [int]: interest value calculated over the nominal amount. ex: 1333
+//	- DiscountAmount [int]: discount value calculated over the nominal amount. ex: 20000
+//	- Date [time.Time]: payment date. ex: 2024-03-14
+//	- Due [time.Time]: due date moved to the next business day if it is a weekend or holiday. ex: 2024-03-11
+//	- DaysLate [int]: calendar days between the payment date and the unadjusted due date, or 0 if not overdue. A due date on a weekend or holiday only delays when the charge becomes overdue, so interest still counts from the original due date. ex: 4
+
+type Charge struct {
+	Amount         int
+	NominalAmount  int
+	FineAmount     int
+	InterestAmount int
+	DiscountAmount int
+	Date           time.Time
+	Due            time.Time
+	DaysLate       int
+}
+
+//	Charge Calculator struct
+//
+//	A Calculator reproduces how the Stark Bank API computes the amount due on Invoices and Boletos
+//	for a given payment date. Payments made up to a discount date receive the discount of the
+//	nearest date still open, and payments made after the due date pay the fine once plus the monthly
+//	interest pro rata for each calendar day late. Due dates and discount dates falling on weekends
+//	or holidays are moved to the next business day. The zero value is ready to use.
+//
+//	Parameters (optional):
+//	- Calendar [*Calendar struct, default national holidays in America/Sao_Paulo]: calendar used to tell business days
+
+type Calculator struct {
+	Calendar *Calendar.Calendar
+}
+
+var defaultCalendar = &Calendar.Calendar{}
+
+func (c Calculator) Invoice(invoice Invoice.Invoice, date time.Time) (Charge, Error.StarkErrors) {
+	//	Compute the amount due on an Invoice
+	//
+	//	The Invoice NominalAmount is used when it is set, such as in Invoices retrieved from the API,
+	//	and its Amount otherwise. Fine and Interest are used as they are, so set them on Invoices
+	//	that were not created yet to quote the API defaults.
+	//
+	//	Parameters (required):
+	//	- invoice [Invoice struct]: Invoice with a Due date. ex: Invoice.Invoice{Amount: 400000, Due: &due, Fine: 2.0, Interest: 1.0}
+	//	- date [time.Time]: payment date. ex: time.Now()
+	//
+	//	Return:
+	//	- Charge struct
+	nominal := invoice.NominalAmount
+	if nominal == 0 {
+		nominal = invoice.Amount
+	}
+	if invoice.Due == nil {
+		return Charge{}, invalid("Invoice must have a due date to compute its charge")
+	}
+	discounts, err := invoiceDiscounts(invoice.Discounts)
+	if err.Errors != nil {
+		return Charge{}, err
+	}
+	return c.Calculate(Terms{
+		NominalAmount: nominal,
+		Due:           *invoice.Due,
+		Fine:          invoice.Fine,
+		Interest:      invoice.Interest,
+		Discounts:     discounts,
+	}, date)
+}
+
+func (c Calculator) Boleto(boleto Boleto.Boleto, date time.Time) (Charge, Error.StarkErrors) {
+	//	Compute the amount due on a Boleto
+	//
+	//	Parameters (required):
+	//	- boleto [Boleto struct]: Boleto with a Due date. ex: Boleto.Boleto{Amount: 400000, Due: &due, Fine: 2.0, Interest: 1.0}
+	//	- date [time.Time]: payment date. ex: time.Now()
+	//
+	//	Return:
+	//	- Charge struct
+	if boleto.Due == nil {
+		return Charge{}, invalid("Boleto must have a due date to compute its charge")
+	}
+	discounts, err := boletoDiscounts(boleto.Discounts)
+	if err.Errors != nil {
+		return Charge{}, err
+	}
+	return c.Calculate(Terms{
+		NominalAmount: boleto.Amount,
+		Due:           boleto.Due.Time(),
+		Fine:          boleto.Fine,
+		Interest:      boleto.Interest,
+		Discounts:     discounts,
+	}, date)
+}
+
+func (c Calculator) Calculate(terms Terms, date time.Time) (Charge, Error.StarkErrors) {
+	//	Compute the amount due on a payment date
+	//
+	//	Parameters (required):
+	//	- terms [Terms struct]: nominal amount, due date, fine, interest and discounts of the charge
+	//	- date [time.Time]: payment date. ex: time.Now()
+	//
+	//	Return:
+	//	- Charge struct
+	if terms.NominalAmount < 0 || terms.Fine < 0 || terms.Interest < 0 {
+		return Charge{}, invalid(fmt.Sprintf("Charge amount, fine and interest must not be negative, but they are %d, %v and %v", terms.NominalAmount, terms.Fine, terms.Interest))
+	}
+
+	calendar := c.calendar()
+	due := calendar.Day(terms.Due)
+	charge := Charge{
+		NominalAmount: terms.NominalAmount,
+		Date:          calendar.Day(date),
+		Due:           calendar.Adjust(due),
+	}
+
+	if charge.Date.After(charge.Due) {
+		charge.DaysLate = int(math.Round(charge.Date.Sub(due).Hours() / 24))
+		charge.FineAmount = percentage(terms.NominalAmount, terms.Fine)
+		charge.InterestAmount = percentage(terms.NominalAmount, terms.Interest*float64(charge.DaysLate)/30)
+	} else {
+		discounts := append([]Discount{}, terms.Discounts...)
+		sort.SliceStable(discounts, func(i, j int) bool {
+			return discounts[i].Due.Before(discounts[j].Due)
+		})
+		for _, discount := range discounts {
+			if discount.Percentage < 0 || discount.Percentage > 100 {
+				return Charge{}, invalid(fmt.Sprintf("Charge discount percentage must be from 0 to 100, but it is %v", discount.Percentage))
+			}
+			if !charge.Date.After(calendar.Adjust(discount.Due)) {
+				charge.DiscountAmount = percentage(terms.NominalAmount, discount.Percentage)
+				break
+			}
+		}
+	}
+
+	charge.Amount = charge.NominalAmount + charge.FineAmount + charge.InterestAmount - charge.DiscountAmount
+	return charge, Error.StarkErrors{}
+}
+
+func (c Calculator) calendar() *Calendar.Calendar {
+	if c.Calendar == nil {
+		return defaultCalendar
+	}
+	return c.Calendar
+}
+
+func invoiceDiscounts(discounts []Invoice.Discount) ([]Discount, Error.StarkErrors) {
+	var parsed []Discount
+	for _, discount := range discounts {
+		if discount.Due == nil {
+			return nil, invalid("Charge discount must have a due date")
+		}
+		parsed = append(parsed, Discount{Percentage: discount.Percentage, Due: *discount.Due})
+	}
+	return parsed, Error.StarkErrors{}
+}
+
+func boletoDiscounts(discounts []Boleto.Discount) ([]Discount, Error.StarkErrors) {
+	var parsed []Discount
+	for _, discount := range discounts {
+		if discount.Date == nil {
+			return nil, invalid("Charge discount must have a date")
+		}
+		parsed = append(parsed, Discount{Percentage: discount.Percentage, Due: discount.Date.Time()})
+	}
+	return parsed, Error.StarkErrors{}
+}
+
+func percentage(amount int, percent float64) int {
+	return int(math.Round(float64(amount) * percent / 100))
+}
+
+func invalid(message string) Error.StarkErrors {
+	return Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidCharge", Message: message}}}
+}
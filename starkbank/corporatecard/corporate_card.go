@@ -36,7 +36,7 @@ import (
 //	- StateCode [string, default sub-issuer state code]: Card holder address state. ex: "GO"
 //	- ZipCode [string, default sub-issuer zip code]: Card holder address zip code. ex: "01311-200"
 //	- Type [string]: Card type. ex: "virtual"
-//	- Status [string]: Current CorporateCard status. ex: "active", "blocked", "canceled", "expired".
+//	- Status [Status]: Current CorporateCard status. ex: "active", "blocked", "canceled" or "expired"
 //	- Number [string]: [EXPANDABLE] Masked card number. Expand to unmask the value. ex: "123".
 //	- SecurityCode [string]: [EXPANDABLE] Masked card verification value (cvv). Expand to unmask the value. ex: "123".
 //	- Expiration [time.Time]: [EXPANDABLE] Masked card expiration datetime. Expand to unmask the value.
@@ -57,7 +57,7 @@ type CorporateCard struct {
 	ZipCode      string                        `json:",omitempty"`
 	Id           string                        `json:",omitempty"`
 	Type         string                        `json:",omitempty"`
-	Status       string                        `json:",omitempty"`
+	Status       Status                        `json:",omitempty"`
 	Number       string                        `json:",omitempty"`
 	SecurityCode string                        `json:",omitempty"`
 	Expiration   string                        `json:",omitempty"`
@@ -129,9 +129,9 @@ func Query(params map[string]interface{}, user user.User) (chan CorporateCard, c
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
-	//		- status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"active", "blocked", "canceled", "expired"}
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: corporatecard.Active or []corporatecard.Status{corporatecard.Active, corporatecard.Canceled}
 	//		- types [slice of strings, default nil]: Card type. ex: []string{"virtual"}
 	//		- holderIds [slice of strings, default nil]: Card holder IDs. ex: []string{"5656565656565656", "4545454545454545"}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"tony", "stark"}
@@ -174,9 +174,9 @@ func Page(params map[string]interface{}, user user.User) ([]CorporateCard, strin
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. Max = 100. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
-	//		- status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"active", "blocked", "canceled", "expired"}
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: corporatecard.Active or []corporatecard.Status{corporatecard.Active, corporatecard.Canceled}
 	//		- types [slice of strings, default nil]: Card type. ex: []string{"virtual"}
 	//		- holderIds [slice of strings, default nil]: Card holder IDs. ex: []string{"5656565656565656", "4545454545454545"}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"tony", "stark"}
@@ -0,0 +1,44 @@
+package corporatecard
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	CorporateCard Status type
+//
+//	CorporateCards may be blocked and unblocked with Update while they are active. Canceled and
+//	expired cards cannot be used anymore.
+
+type Status string
+
+const (
+	Active   Status = "active"
+	Blocked  Status = "blocked"
+	Canceled Status = "canceled"
+	Expired  Status = "expired"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"active"},
+	Transitions: map[string][]string{
+		"active":   {"blocked", "canceled", "expired"},
+		"blocked":  {"active", "canceled", "expired"},
+		"canceled": {},
+		"expired":  {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "active"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
@@ -29,7 +29,7 @@ import (
 //
 //	Attributes (return-only):
 //	- Id [string]: Unique id returned when CorporateHolder is created. ex: "5656565656565656"
-//	- Status [string]: Current CorporateHolder status. ex: "active", "blocked", "canceled"
+//	- Status [Status]: Current CorporateHolder status. ex: "active", "blocked" or "canceled"
 //	- Updated [time.Time]: Latest update datetime for the CorporateHolder. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: Creation datetime for the CorporateHolder. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 
@@ -40,7 +40,7 @@ type CorporateHolder struct {
 	Permissions []permission.Permission       `json:",omitempty"`
 	Tags        []string                      `json:",omitempty"`
 	Id          string                        `json:",omitempty"`
-	Status      string                        `json:",omitempty"`
+	Status      Status                        `json:",omitempty"`
 	Updated     *time.Time                    `json:",omitempty"`
 	Created     *time.Time                    `json:",omitempty"`
 }
@@ -101,9 +101,9 @@ func Query(params map[string]interface{}, user user.User) (chan CorporateHolder,
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
-	//		- status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"active", "blocked", "canceled"}
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: corporateholder.Active or []corporateholder.Status{corporateholder.Active, corporateholder.Canceled}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"tony", "stark"}
 	//		- expand [string, default nil]: Fields to expand information. ex: "rules"
 	//		- ids [slice of strings, default nil]: Slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
@@ -145,9 +145,9 @@ func Page(params map[string]interface{}, user user.User) ([]CorporateHolder, str
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. Max = 100. ex: 35
 	//		- ids [slice of strings, default nil]: Slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
-	//		- status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"active", "blocked", "canceled"}
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: corporateholder.Active or []corporateholder.Status{corporateholder.Active, corporateholder.Canceled}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"tony", "stark"}
 	//		- expand [string, default nil]: Fields to expand information. ex: "rules"
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -0,0 +1,42 @@
+package corporateholder
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	CorporateHolder Status type
+//
+//	CorporateHolders may be blocked and unblocked with Update while they are active. Canceled
+//	holders cannot be reactivated.
+
+type Status string
+
+const (
+	Active   Status = "active"
+	Blocked  Status = "blocked"
+	Canceled Status = "canceled"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"active"},
+	Transitions: map[string][]string{
+		"active":   {"blocked", "canceled"},
+		"blocked":  {"active", "canceled"},
+		"canceled": {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "active"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
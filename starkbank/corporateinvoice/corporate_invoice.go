@@ -2,6 +2,7 @@ package corporateinvoice
 
 import (
 	"encoding/json"
+	"github.com/starkbank/sdk-go/starkbank/qrcode"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -29,7 +30,7 @@ import (
 //  - Brcode [string]: BR Code for the Invoice payment. ex: "00020101021226930014br.gov.bcb.pix2571brcode-h.development.starkinfra.com/v2/d7f6546e194d4c64a153e8f79f1c41ac5204000053039865802BR5925Stark Bank S.A. - Institu6009Sao Paulo62070503***63042109"
 //  - Due [time.Time]: Invoice due and expiration date in UTC ISO format. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
 //  - Link [string]: public Invoice webpage URL. ex: "https://starkbank-card-issuer.development.starkbank.com/invoicelink/d7f6546e194d4c64a153e8f79f1c41ac"
-//	- Status [string]: current CorporateInvoice status. ex: "created", "expired", "overdue", "paid"
+//	- Status [Status]: current CorporateInvoice status. ex: "created", "paid", "overdue" or "expired"
 //	- CorporateTransactionId [string]: ledger transaction ids linked to this CorporateInvoice. ex: "corporate-invoice/5656565656565656"
 //	- Updated [time.Time]: latest update datetime for the CorporateInvoice. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- Created [time.Time]: creation datetime for the CorporateInvoice. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
@@ -43,7 +44,7 @@ type CorporateInvoice struct {
 	Brcode                 string     `json:",omitempty"`
 	Due                    *time.Time `json:",omitempty"`
 	Link                   string     `json:",omitempty"`
-	Status                 string     `json:",omitempty"`
+	Status                 Status     `json:",omitempty"`
 	CorporateTransactionId string     `json:",omitempty"`
 	Updated                *time.Time `json:",omitempty"`
 	Created                *time.Time `json:",omitempty"`
@@ -80,9 +81,9 @@ func Query(params map[string]interface{}, user user.User) (chan CorporateInvoice
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
-	//		- status [slice of strings, default nil]: filter for status of retrieved structs. ex: []string{"created", "expired", "overdue", "paid"}
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: corporateinvoice.Paid or []corporateinvoice.Status{corporateinvoice.Paid, corporateinvoice.Expired}
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"tony", "stark"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
@@ -121,9 +122,9 @@ func Page(params map[string]interface{}, user user.User) ([]CorporateInvoice, st
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. Max = 100. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
-	//		- status [slice of strings, default nil]: filter for status of retrieved structs. ex: []string{"created", "expired", "overdue", "paid"}
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: corporateinvoice.Paid or []corporateinvoice.Status{corporateinvoice.Paid, corporateinvoice.Expired}
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"tony", "stark"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
@@ -138,3 +139,25 @@ func Page(params map[string]interface{}, user user.User) ([]CorporateInvoice, st
 	}
 	return corporateInvoices, cursor, err
 }
+
+func (c CorporateInvoice) QrcodePng(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the CorporateInvoice QR Code png locally
+	//
+	//	The CorporateInvoice must have been created or retrieved first, since its Brcode is returned by the API.
+	//	The params are the ones described in qrcode.Png.
+	if c.Brcode == "" {
+		return nil, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidQrcode", Message: "CorporateInvoice has no BR Code to render"}}}
+	}
+	return qrcode.Png(c.Brcode, params)
+}
+
+func (c CorporateInvoice) QrcodeSvg(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the CorporateInvoice QR Code svg locally
+	//
+	//	The CorporateInvoice must have been created or retrieved first, since its Brcode is returned by the API.
+	//	The params are the ones described in qrcode.Svg.
+	if c.Brcode == "" {
+		return nil, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidQrcode", Message: "CorporateInvoice has no BR Code to render"}}}
+	}
+	return qrcode.Svg(c.Brcode, params)
+}
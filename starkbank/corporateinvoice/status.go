@@ -0,0 +1,43 @@
+package corporateinvoice
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	CorporateInvoice Status type
+//
+//	CorporateInvoices are created and may be paid until they expire, even after becoming overdue.
+
+type Status string
+
+const (
+	Created Status = "created"
+	Paid    Status = "paid"
+	Overdue Status = "overdue"
+	Expired Status = "expired"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"paid"},
+	Transitions: map[string][]string{
+		"created": {"paid", "overdue", "expired"},
+		"overdue": {"paid", "expired"},
+		"paid":    {},
+		"expired": {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "paid"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
@@ -38,7 +38,7 @@ import (
 //	- MethodCode [string]: method code. Options: "chip", "token", "server", "manual", "magstripe" or "contactless"
 //	- Tags [slice of strings]: list of strings for tagging returned by the sub-issuer during the authorization. ex: new List<string>{ "travel", "food" }
 //	- CorporateTransactionIds [slice of strings]: ledger transaction ids linked to this Purchase
-//	- Status [string]: current CorporateCard status. Options: "approved", "canceled", "denied", "confirmed" or "voided"
+//	- Status [Status]: current CorporateCard status. ex: "approved", "denied", "confirmed", "canceled" or "voided"
 //	- Updated [DateTime]: latest update DateTime for the CorporatePurchase. ex: DateTime(2020, 3, 10, 10, 30, 0, 0)
 //	- Created [DateTime]: creation DateTime for the CorporatePurchase. ex: DateTime(2020, 3, 10, 10, 30, 0, 0)
 
@@ -68,7 +68,7 @@ type CorporatePurchase struct {
 	MethodCode              string     `json:",omitempty"`
 	Tags                    []string   `json:",omitempty"`
 	CorporateTransactionIds []string   `json:",omitempty"`
-	Status                  string     `json:",omitempty"`
+	Status                  Status     `json:",omitempty"`
 	Updated                 *time.Time `json:",omitempty"`
 	Created                 *time.Time `json:",omitempty"`
 }
@@ -105,12 +105,12 @@ func Query(params map[string]interface{}, user user.User) (chan CorporatePurchas
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
 	//		- merchantCategoryTypes [slice of strings, default nil]: merchant category type. ex: []string]{"health"}
 	//		- holderIds [slice of strings, default nil]: Card holder IDs. ex: []string{"5656565656565656", "4545454545454545"}
 	//		- cardIds [slice of strings, default nil]: Card  IDs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"approved", "canceled", "denied", "confirmed", "voided"}
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: corporatepurchase.Approved or []corporatepurchase.Status{corporatepurchase.Approved, corporatepurchase.Denied}
 	//		- ids [slice of strings, default nil, default nil]: Purchase IDs
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
@@ -149,12 +149,12 @@ func Page(params map[string]interface{}, user user.User) ([]CorporatePurchase, s
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
 	//		- merchantCategoryTypes [slice of strings, default nil]: merchant category type. ex: []string]{"health"}
 	//		- holderIds [slice of strings, default nil]: Card holder IDs. ex: []string{"5656565656565656", "4545454545454545"}
 	//		- cardIds [slice of strings, default nil]: Card  IDs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [slice of strings, default nil]: Filter for status of retrieved structs. ex: []string{"approved", "canceled", "denied", "confirmed", "voided"}
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: corporatepurchase.Approved or []corporatepurchase.Status{corporatepurchase.Approved, corporatepurchase.Denied}
 	//		- ids [slice of strings, default nil, default nil]: Purchase IDs
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
@@ -189,7 +189,7 @@ func Parse(content string, signature string, user user.User) (CorporatePurchase,
 	//	Return:
 	//	- parsed CorporatePurchase struct
 	var corporatePurchase CorporatePurchase
-	response, err := utils.ParseAndVerify(content, signature, "", user)
+	response, err := utils.ParseAndVerify(content, signature, user)
 	if err.Errors != nil {
 		return corporatePurchase, err
 	}
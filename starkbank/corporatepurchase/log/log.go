@@ -66,8 +66,8 @@ func Query(params map[string]interface{}, user user.User) (chan Log, chan Error.
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
 	//		- types [slice of strings, default nil]: Filter for log event types. ex: []string{"approved", "canceled", "confirmed", "denied", "reversed", "voided"}
 	//		- purchaseIds [slice of strings, default nil]: Slice of Purchase ids to filter logs. ex: []string{"5656565656565656", "4545454545454545"}
 	//		- ids [slice of strings, default nil]: Slice of CorporatePurchase ids to filter logs. ex: []string{"5656565656565656", "4545454545454545"}
@@ -108,8 +108,8 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. Max = 100. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
 	//		- types [slice of strings, default nil]: Filter for log event types. ex: []string{"approved", "canceled", "confirmed", "denied", "reversed", "voided"}
 	//		- purchaseIds [slice of strings, default nil]: Slice of Purchase ids to filter logs. ex: []string{"5656565656565656", "4545454545454545"}
 	//		- ids [slice of strings, default nil]: Slice of CorporatePurchase ids to filter logs. ex: []string{"5656565656565656", "4545454545454545"}
@@ -126,3 +126,33 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	}
 	return corporatePurchaseLogs, cursor, err
 }
+
+var _ utils.Log = Log{}
+
+func (l Log) LogId() string {
+	return l.Id
+}
+
+func (l Log) LogType() string {
+	return l.Type
+}
+
+func (l Log) LogCreated() *time.Time {
+	return l.Created
+}
+
+func (l Log) EntityId() string {
+	return l.Purchase.Id
+}
+
+func (l Log) EntityStatus() string {
+	return string(l.Purchase.Status)
+}
+
+func (l Log) LogErrors() []string {
+	var errors []interface{}
+	for _, err := range l.Errors {
+		errors = append(errors, err)
+	}
+	return utils.ErrorMessages(errors)
+}
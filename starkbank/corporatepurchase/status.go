@@ -0,0 +1,46 @@
+package corporatepurchase
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	CorporatePurchase Status type
+//
+//	CorporatePurchases are approved or denied at authorization. Approved purchases are confirmed
+//	when settled, canceled when the authorization is undone and voided when they are reversed.
+
+type Status string
+
+const (
+	Approved  Status = "approved"
+	Denied    Status = "denied"
+	Confirmed Status = "confirmed"
+	Canceled  Status = "canceled"
+	Voided    Status = "voided"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"approved", "confirmed"},
+	Transitions: map[string][]string{
+		"approved":  {"confirmed", "canceled", "voided"},
+		"confirmed": {"voided"},
+		"denied":    {},
+		"canceled":  {},
+		"voided":    {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "approved" or "confirmed"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
@@ -63,8 +63,8 @@ func Query(params map[string]interface{}, user user.User) (chan CorporateTransac
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
 	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "approved", "canceled", "denied", "confirmed" or "voided"
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"tony", "stark"}
 	//		- externalIds [slice of strings, default nil]: External IDs. ex: []string{"5656565656565656", "4545454545454545"}
@@ -106,8 +106,8 @@ func Page(params map[string]interface{}, user user.User) ([]CorporateTransaction
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. Max = 100. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date.  ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date.  ex: "2022-11-10"
 	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "approved", "canceled", "denied", "confirmed" or "voided"
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"tony", "stark"}
 	//		- externalIds [slice of strings, default nil]: External IDs. ex: []string{"5656565656565656", "4545454545454545"}
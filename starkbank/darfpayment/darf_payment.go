@@ -2,6 +2,8 @@ package darfpayment
 
 import (
 	"encoding/json"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -18,20 +20,20 @@ import (
 //	- Description [string]: Text to be displayed in your statement (min. 10 characters). ex: "payment ABC"
 //	- RevenueCode [string]: 4-digit tax code assigned by Federal Revenue. ex: "5948"
 //	- TaxId [string]: tax id (formatted or unformatted) of the payer. ex: "12.345.678/0001-95"
-//	- Competence [time.Time]: competence month of the service. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
+//	- Competence [date.Date]: competence month of the service. ex: date.New(2020, time.March, 10),
 //	- NominalAmount [int]: amount due in cents without fee or interest. ex: 23456 (= R$ 234.56)
 //	- FineAmount [int]: fixed amount due in cents for fines. ex: 234 (= R$ 2.34)
 //	- InterestAmount [int]: amount due in cents for interest. ex: 456 (= R$ 4.56)
-//	- Due [time.Time]: due date for payment. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
+//	- Due [date.Date]: due date for payment. ex: date.New(2020, time.March, 10),
 //
 //	Parameters (optional):
 //	- ReferenceNumber [string, default nil]: number assigned to the region of the tax. ex: "08.1.17.00-4"
-//	- Scheduled [time.Time, default today]: payment scheduled date. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
+//	- Scheduled [date.Date, default today]: payment scheduled date. ex: date.New(2020, time.March, 10),
 //	- Tags [slice of strings, default nil]: slice of strings for tagging. ex: []string{"John", "Paul"}
 //
 //	Attributes (return-only):
 //	- Id [string]: unique id returned when payment is created. ex: "5656565656565656"
-//	- Status [string]: current payment status. ex: "success" or "failed"
+//	- Status [Status]: current payment status. ex: "created", "processing", "success", "failed" or "canceled"
 //	- Amount [int]: Total amount due calculated from other amounts. ex: 24146 (= R$ 241.46)
 //	- Fee [int]: fee charged when the DarfPayment is processed. ex: 0 (= R$ 0.00)
 //	- TransactionIds [slice of strings]: ledger transaction ids linked to this DarfPayment. ex: []string{"19827356981273"}
@@ -43,15 +45,15 @@ type DarfPayment struct {
 	Description     string     `json:",omitempty"`
 	RevenueCode     string     `json:",omitempty"`
 	TaxId           string     `json:",omitempty"`
-	Competence      *time.Time `json:",omitempty"`
+	Competence      *Date.Date `json:",omitempty"`
 	NominalAmount   int        `json:",omitempty"`
 	FineAmount      int        `json:",omitempty"`
 	InterestAmount  int        `json:",omitempty"`
-	Due             *time.Time `json:",omitempty"`
+	Due             *Date.Date `json:",omitempty"`
 	ReferenceNumber string     `json:",omitempty"`
-	Scheduled       *time.Time `json:",omitempty"`
+	Scheduled       *Date.Date `json:",omitempty"`
 	Tags            []string   `json:",omitempty"`
-	Status          string     `json:",omitempty"`
+	Status          Status     `json:",omitempty"`
 	Amount          int        `json:",omitempty"`
 	Fee             int        `json:",omitempty"`
 	TransactionIds  []string   `json:",omitempty"`
@@ -74,6 +76,14 @@ func Create(payments []DarfPayment, user user.User) ([]DarfPayment, Error.StarkE
 	//
 	//	Return:
 	//	- Slice of DarfPayment structs with updated attributes
+	var errors []Error.StarkError
+	for _, payment := range payments {
+		errors = append(errors, TaxId.Check(payment.TaxId).Errors...)
+	}
+	if errors != nil {
+		return payments, Error.StarkErrors{Errors: errors}
+	}
+
 	create, err := utils.Multi(resource, payments, nil, user)
 	unmarshalError := json.Unmarshal(create, &payments)
 	if unmarshalError != nil {
@@ -129,11 +139,11 @@ func Query(params map[string]interface{}, user user.User) (chan DarfPayment, cha
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: list of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: darfpayment.Success or []darfpayment.Status{darfpayment.Success, darfpayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -171,11 +181,11 @@ func Page(params map[string]interface{}, user user.User) ([]DarfPayment, string,
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: cursor returned on the previous page function call
 	//		- limit [int, default 100]: maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: list of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: darfpayment.Success or []darfpayment.Status{darfpayment.Success, darfpayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
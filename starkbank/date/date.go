@@ -0,0 +1,138 @@
+package date
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"time"
+)
+
+//	Date struct
+//
+//	A civil date, without time of the day or time zone, such as the Scheduled date of a BoletoPayment
+//	or the Due date of a Boleto. Dates are sent to and received from the Stark Bank API as "2006-01-02"
+//	and interpreted in America/Sao_Paulo, so a Date never moves to the previous or next day because
+//	of the offset of the machine running the SDK. Datetimes are always converted to America/Sao_Paulo
+//	before their day is used, so New should be used for dates known by their year, month and day.
+//	The zero value is no date.
+//
+//	Parameters (required):
+//	- Year [int]: calendar year. ex: 2024
+//	- Month [time.Month]: calendar month. ex: time.March
+//	- Day [int]: day of the month. ex: 10
+
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+const Layout = "2006-01-02"
+
+var Location = loadSaoPaulo()
+
+func loadSaoPaulo() *time.Location {
+	location, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		return time.FixedZone("-03", -3*60*60)
+	}
+	return location
+}
+
+func New(year int, month time.Month, day int) Date {
+	//	Create a Date, normalizing out of range days and months as time.Date does. ex: date.New(2024, time.March, 10)
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+}
+
+func Of(t time.Time) Date {
+	//	Get the Date of a time.Time in America/Sao_Paulo
+	//	ex: date.Of(time.Date(2024, 3, 10, 2, 59, 59, 0, time.UTC)) is 2024-03-09
+	t = t.In(Location)
+	return Date{Year: t.Year(), Month: t.Month(), Day: t.Day()}
+}
+
+func Today() Date {
+	//	Get the current Date in America/Sao_Paulo
+	return Of(time.Now())
+}
+
+func Parse(value string) (Date, Error.StarkErrors) {
+	//	Parse a Date
+	//
+	//	Parameters (required):
+	//	- value [string]: date or RFC 3339 datetime, such as the ones returned by the API. ex: "2024-03-10" or "2024-03-10T02:59:59.999999+00:00"
+	//
+	//	Return:
+	//	- Date struct. ex: date.Date{Year: 2024, Month: time.March, Day: 10}
+	if parsed, err := time.Parse(Layout, value); err == nil {
+		return New(parsed.Year(), parsed.Month(), parsed.Day()), Error.StarkErrors{}
+	}
+	if parsed, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return Of(parsed), Error.StarkErrors{}
+	}
+	return Date{}, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidDate", Message: fmt.Sprintf("Date must be formatted as %q or as a RFC 3339 datetime, but it is %q", Layout, value)}}}
+}
+
+func (d Date) Time() time.Time {
+	//	Get the midnight of the Date in America/Sao_Paulo
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, Location)
+}
+
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+func (d Date) Before(other Date) bool {
+	return d.compare(other) < 0
+}
+
+func (d Date) After(other Date) bool {
+	return d.compare(other) > 0
+}
+
+func (d Date) AddDays(days int) Date {
+	return New(d.Year, d.Month, d.Day+days)
+}
+
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	var value *string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if value == nil || *value == "" {
+		*d = Date{}
+		return nil
+	}
+	parsed, err := Parse(*value)
+	if err.Errors != nil {
+		return errors.New(err.Errors[0].Message)
+	}
+	*d = parsed
+	return nil
+}
+
+func (d Date) compare(other Date) int {
+	switch {
+	case d.Year != other.Year:
+		return d.Year - other.Year
+	case d.Month != other.Month:
+		return int(d.Month - other.Month)
+	}
+	return d.Day - other.Day
+}
@@ -22,7 +22,7 @@ import (
 //	- AccountType [string]: Payer bank account type. ex: "checking"
 //	- Amount [int]: Deposit value in cents. ex: 1234 (= R$ 12.34)
 //	- Type [string]: Type of settlement that originated the deposit. ex: "pix" or "ted"
-//	- Status [string]: Current Deposit status. ex: "created"
+//	- Status [Status]: Current Deposit status. ex: "created" or "credited"
 //	- Tags [slice of strings]: Slice of strings that are tagging the deposit. ex: []string{"reconciliationId", "txId"}
 //	- DisplayDescription [string, default nil]: optional description to be shown in the receiver bank interface. ex: "Payment for service 1234"
 //	- Fee [int]: Fee charged by this deposit. ex: 50 (= R$ 0.50)
@@ -40,7 +40,7 @@ type Deposit struct {
 	AccountType    		string     `json:",omitempty"`
 	Amount         		int        `json:",omitempty"`
 	Type           		string     `json:",omitempty"`
-	Status         		string     `json:",omitempty"`
+	Status         		Status     `json:",omitempty"`
 	Tags           		[]string   `json:",omitempty"`
 	DisplayDescription  string	   `json:",omitempty"`
 	Fee            		int        `json:",omitempty"`
@@ -81,9 +81,9 @@ func Query(params map[string]interface{}, user user.User) (chan Deposit, chan Er
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "paid" or "registered"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: deposit.Credited or []deposit.Status{deposit.Credited}
 	//		- sort [string, default "-created"]: Sort order considered in response. Valid options are "created" or "-created".
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
@@ -124,9 +124,9 @@ func Page(params map[string]interface{}, user user.User) ([]Deposit, string, Err
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "paid" or "registered"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: deposit.Credited or []deposit.Status{deposit.Credited}
 	//		- sort [string, default "-created"]: Sort order considered in response. Valid options are "created" or "-created".
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
@@ -0,0 +1,40 @@
+package deposit
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	Deposit Status type
+//
+//	Deposits are created when a transfer to your account is received and are credited once the
+//	amount is added to your balance.
+
+type Status string
+
+const (
+	Created  Status = "created"
+	Credited Status = "credited"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"credited"},
+	Transitions: map[string][]string{
+		"created":  {"credited"},
+		"credited": {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "credited"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
@@ -24,7 +24,7 @@ import (
 //	- BranchCode [string]: Encrypted bank account branch code associated with the DICT key. ex: "ZW5jcnlwdGVkLWJyYW5jaC1jb2Rl"
 //	- AccountNumber [string]: Encrypted bank account number associated with the DICT key. ex: "ZW5jcnlwdGVkLWFjY291bnQtbnVtYmVy"
 //	- AccountType [string]: Bank account type associated with the DICT key. ex: "checking", "savings", "salary" or "payment"
-//	- Status [string]: Current DICT key status. ex: "created", "registered", "canceled" or "failed"
+//	- Status [Status]: Current DICT key status. ex: "created", "registered", "canceled" or "failed"
 
 type DictKey struct {
 	Id             string     `json:",omitempty"`
@@ -37,7 +37,7 @@ type DictKey struct {
 	BranchCode     string     `json:",omitempty"`
 	AccountNumber  string     `json:",omitempty"`
 	AccountType    string     `json:",omitempty"`
-	Status         string     `json:",omitempty"`
+	Status         Status     `json:",omitempty"`
 }
 
 var resource = map[string]string{"name": "DictKey"}
@@ -73,10 +73,10 @@ func Query(params map[string]interface{}, user user.User) (chan DictKey, chan Er
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
 	//		- type [string, default nil]: DictKey type. ex: "cpf", "cnpj", "phone", "email" or "evp"
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: dictkey.Registered or []dictkey.Status{dictkey.Registered, dictkey.Canceled}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -115,10 +115,10 @@ func Page(params map[string]interface{}, user user.User) ([]DictKey, string, Err
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
 	//		- type [string, default nil]: DictKey type. ex: "cpf", "cnpj", "phone", "email" or "evp"
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- ids [slice of strings, default nil]: List of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: dictkey.Registered or []dictkey.Status{dictkey.Registered, dictkey.Canceled}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -0,0 +1,44 @@
+package dictkey
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	DictKey Status type
+//
+//	DictKeys are created and registered at the DICT, which may fail. Registered keys may be
+//	canceled by their owners.
+
+type Status string
+
+const (
+	Created    Status = "created"
+	Registered Status = "registered"
+	Canceled   Status = "canceled"
+	Failed     Status = "failed"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"registered"},
+	Transitions: map[string][]string{
+		"created":    {"registered", "failed"},
+		"registered": {"canceled"},
+		"canceled":   {},
+		"failed":     {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "registered"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
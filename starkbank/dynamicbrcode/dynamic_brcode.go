@@ -3,6 +3,7 @@ package dynamicbrcode
 import (
 	"encoding/json"
 	"github.com/starkbank/sdk-go/starkbank/dynamicbrcode/rule"
+	"github.com/starkbank/sdk-go/starkbank/qrcode"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -25,6 +26,7 @@ import (
 //	Parameters (optional):
 //	- Expiration [int, default 3600 (1 hour)]: time interval in seconds between due date and expiration date. ex: 123456789
 //	- Tags [slice of strings, default []]: list of strings for tagging, these will be passed to the respective Deposit resource when paid
+//	- Rules [slice of DynamicBrcode.Rule structs, default nil]: slice of DynamicBrcode.Rule structs for modifying DynamicBrcode behavior. ex: []rule.Rule{rule.AllowedTaxIds("012.345.678-90")}
 //
 //	Attributes (return-only):
 //	- Id [string]: id returned on creation, this is the BR code. ex: "00020126360014br.gov.bcb.pix0114+552840092118152040000530398654040.095802BR5915Jamie Lannister6009Sao Paulo620705038566304FC6C"
@@ -61,6 +63,14 @@ func Create(brcodes []DynamicBrcode, user user.User) ([]DynamicBrcode, Error.Sta
 	//
 	//	Return:
 	//	- Slice of DynamicBrcode structs with updated attributes
+	var errors []Error.StarkError
+	for _, brcode := range brcodes {
+		errors = append(errors, rule.Check(brcode.Rules).Errors...)
+	}
+	if errors != nil {
+		return brcodes, Error.StarkErrors{Errors: errors}
+	}
+
 	create, err := utils.Multi(resource, brcodes, nil, user)
 	unmarshalError := json.Unmarshal(create, &brcodes)
 	if unmarshalError != nil {
@@ -99,8 +109,8 @@ func Query(params map[string]interface{}, user user.User) (chan DynamicBrcode, c
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: tags to filter retrieved objects. ex: ["tony", "stark"]
 	//		- uuids [slice of strings, default nil]: list of uuids to filter retrieved objects. ex: ["901e71f2447c43c886f58366a5432c4b", "4e2eab725ddd495f9c98ffd97440702d"]
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -140,8 +150,8 @@ func Page(params map[string]interface{}, user user.User) ([]DynamicBrcode, strin
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- uuids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -157,3 +167,25 @@ func Page(params map[string]interface{}, user user.User) ([]DynamicBrcode, strin
 	}
 	return dynamicBrcodes, cursor, err
 }
+
+func (d DynamicBrcode) QrcodePng(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the DynamicBrcode QR Code png locally
+	//
+	//	The DynamicBrcode must have been created or retrieved first, since its Id is the BR Code.
+	//	The params are the ones described in qrcode.Png.
+	if d.Id == "" {
+		return nil, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidQrcode", Message: "DynamicBrcode has no BR Code to render"}}}
+	}
+	return qrcode.Png(d.Id, params)
+}
+
+func (d DynamicBrcode) QrcodeSvg(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the DynamicBrcode QR Code svg locally
+	//
+	//	The DynamicBrcode must have been created or retrieved first, since its Id is the BR Code.
+	//	The params are the ones described in qrcode.Svg.
+	if d.Id == "" {
+		return nil, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidQrcode", Message: "DynamicBrcode has no BR Code to render"}}}
+	}
+	return qrcode.Svg(d.Id, params)
+}
@@ -1,8 +1,16 @@
 package rule
 
+import (
+	"fmt"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
+	"github.com/starkbank/sdk-go/starkbank/utils"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+)
+
 //	DynamicBrCode.Rule struct
 //
 //	The DynamicBrCode.Rule struct modifies the behavior of DynamicBrCode structs when passed as an argument upon their creation.
+//	Use the constructors, such as AllowedTaxIds, to build rules that are checked before the DynamicBrcodes are sent to the API.
 //
 //	Attributes (return-only):
 //	- Key [string]: Rule to be customized, describes what DynamicBrCode behavior will be altered. ex: "allowedTaxIds"
@@ -12,3 +20,35 @@ type Rule struct {
 	Key   string   `json:",omitempty"`
 	Value []string `json:",omitempty"`
 }
+
+const AllowedTaxIdsKey = "allowedTaxIds"
+
+func AllowedTaxIds(taxIds ...string) Rule {
+	//	Build a rule with the only tax IDs allowed to pay the DynamicBrcode. ex: rule.AllowedTaxIds("012.345.678-90", "45.059.493/0001-73")
+	return Rule{Key: AllowedTaxIdsKey, Value: taxIds}
+}
+
+func (r Rule) AllowedTaxIds() ([]string, bool) {
+	//	Get the tax IDs of an "allowedTaxIds" rule. The second return is false for other rules
+	return r.Value, r.Key == AllowedTaxIdsKey
+}
+
+func Check(rules []Rule) Error.StarkErrors {
+	//	Validate the value of each rule with a constructor, returning an "invalidRule" error for each invalid one.
+	//	Rules with other keys are sent to the API unchanged.
+	return utils.CheckRules(len(rules), func(index int) string {
+		rule := rules[index]
+		switch rule.Key {
+		case AllowedTaxIdsKey:
+			if len(rule.Value) == 0 {
+				return fmt.Sprintf("DynamicBrcode rule %q must have at least one tax ID", rule.Key)
+			}
+			for _, taxId := range rule.Value {
+				if err := TaxId.Validate(taxId); err.Errors != nil {
+					return fmt.Sprintf("DynamicBrcode rule %q has an invalid tax ID: %s", rule.Key, err.Errors[0].Message)
+				}
+			}
+		}
+		return ""
+	})
+}
@@ -0,0 +1,181 @@
+package event
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//	Event Acknowledgment struct
+//
+//	Outcome of setting a single Event as delivered with Acknowledge or AcknowledgeStream.
+//
+//	Attributes:
+//	- Id [string]: Event unique id. ex: "5656565656565656"
+//	- Event [Event struct]: updated Event. Empty if the update failed
+//	- Attempts [int]: number of update requests sent for this Event. ex: 1
+//	- Errors [StarkErrors]: errors of the last attempt. Errors is nil on success
+
+type Acknowledgment struct {
+	Id       string
+	Event    Event
+	Attempts int
+	Errors   Error.StarkErrors
+}
+
+type acknowledgeJob struct {
+	index int
+	id    string
+}
+
+const acknowledgeRetryDelay = 500 * time.Millisecond
+const acknowledgeRequestsPerSecond = 10
+
+func Acknowledge(ids []string, params map[string]interface{}, user user.User) []Acknowledgment {
+	//	Set many notification Events as delivered
+	//
+	//	Update Events concurrently, retrying transient failures such as connection errors
+	//	and internal server errors.
+	//
+	//	Parameters (required):
+	//	- ids [slice of strings]: Event unique ids. ex: []string{"5656565656565656", "4545454545454545"}
+	//
+	//	Parameters (optional):
+	//  - params [map[string]interface{}, default nil]: map of parameters for the acknowledgment
+	//		- concurrency [int, default 10]: maximum number of simultaneous requests. ex: 5
+	//		- requestsPerSecond [int, default 10]: maximum number of requests sent per second, including retries. ex: 20
+	//		- retries [int, default 3]: maximum number of retries of each Event after transient failures. ex: 5
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
+	//
+	//	Return:
+	//	- Slice of Acknowledgment structs in the same order as the ids
+	acknowledgments := make([]Acknowledgment, len(ids))
+	jobs := make(chan acknowledgeJob)
+	go func() {
+		for index, id := range ids {
+			jobs <- acknowledgeJob{index: index, id: id}
+		}
+		close(jobs)
+	}()
+	for result := range acknowledgeJobs(jobs, params, user) {
+		acknowledgments[result.index] = result.acknowledgment
+	}
+	return acknowledgments
+}
+
+func AcknowledgeStream(ids chan string, params map[string]interface{}, user user.User) chan Acknowledgment {
+	//	Set a stream of notification Events as delivered
+	//
+	//	Same as Acknowledge, but reads the ids from a channel and sends each outcome as soon as
+	//	it is known. The returned channel is closed after the ids channel is closed and drained.
+	//
+	//	Parameters (required):
+	//	- ids [channel of strings]: Event unique ids. ex: "5656565656565656"
+	//
+	//	Parameters (optional):
+	//  - params [map[string]interface{}, default nil]: map of parameters for the acknowledgment
+	//		- concurrency [int, default 10]: maximum number of simultaneous requests. ex: 5
+	//		- requestsPerSecond [int, default 10]: maximum number of requests sent per second, including retries. ex: 20
+	//		- retries [int, default 3]: maximum number of retries of each Event after transient failures. ex: 5
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
+	//
+	//	Return:
+	//	- Channel of Acknowledgment structs in completion order
+	acknowledgments := make(chan Acknowledgment)
+	jobs := make(chan acknowledgeJob)
+	go func() {
+		for id := range ids {
+			jobs <- acknowledgeJob{id: id}
+		}
+		close(jobs)
+	}()
+	go func() {
+		for result := range acknowledgeJobs(jobs, params, user) {
+			acknowledgments <- result.acknowledgment
+		}
+		close(acknowledgments)
+	}()
+	return acknowledgments
+}
+
+type acknowledgeResult struct {
+	index          int
+	acknowledgment Acknowledgment
+}
+
+func acknowledgeJobs(jobs chan acknowledgeJob, params map[string]interface{}, user user.User) chan acknowledgeResult {
+	concurrency := intParam(params, "concurrency", 10)
+	retries := intParam(params, "retries", 3)
+	requestsPerSecond := intParam(params, "requestsPerSecond", acknowledgeRequestsPerSecond)
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if requestsPerSecond < 1 {
+		requestsPerSecond = 1
+	}
+
+	results := make(chan acknowledgeResult)
+	go func() {
+		ticker := time.NewTicker(time.Second / time.Duration(requestsPerSecond))
+		defer ticker.Stop()
+
+		var workers sync.WaitGroup
+		for i := 0; i < concurrency; i++ {
+			workers.Add(1)
+			go func() {
+				defer workers.Done()
+				for job := range jobs {
+					results <- acknowledgeResult{index: job.index, acknowledgment: acknowledge(job.id, retries, ticker.C, user)}
+				}
+			}()
+		}
+		workers.Wait()
+		close(results)
+	}()
+	return results
+}
+
+func acknowledge(id string, retries int, throttle <-chan time.Time, user user.User) Acknowledgment {
+	acknowledgment := Acknowledgment{Id: id}
+	delay := acknowledgeRetryDelay
+	for {
+		<-throttle
+		acknowledgment.Attempts++
+		event, err := Update(id, map[string]interface{}{"isDelivered": true}, user)
+		if err.Errors == nil {
+			acknowledgment.Event = event
+			acknowledgment.Errors = Error.StarkErrors{}
+			return acknowledgment
+		}
+		acknowledgment.Errors = err
+		if !isTransient(err) || acknowledgment.Attempts > retries {
+			return acknowledgment
+		}
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func isTransient(err Error.StarkErrors) bool {
+	for _, e := range err.Errors {
+		if e.Code != "internalServerError" && e.Code != "unknownError" {
+			return false
+		}
+	}
+	return true
+}
+
+func intParam(params map[string]interface{}, key string, fallback int) int {
+	value, ok := params[key]
+	if !ok || value == nil {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(fmt.Sprintf("%v", value))
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
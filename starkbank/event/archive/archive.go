@@ -0,0 +1,498 @@
+package archive
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	"github.com/starkbank/sdk-go/starkbank/event/dispatcher"
+	"github.com/starkbank/sdk-go/starkbank/utils"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+//	Event Archive struct
+//
+//	An Archive retains the Events received at your webhook endpoint or fetched from the
+//	Stark Bank API in local files, keeping the raw content and digital signature next to
+//	the parsed Event for audits. Records are appended to numbered segment files in the
+//	Directory and indexed in memory by id, subscription, workspace id, creation date and
+//	entity id when the Archive is first used, so that they can be queried and replayed offline.
+//	A record left incomplete by a crash while it was appended is discarded when the Archive
+//	is loaded, and other unreadable records are skipped and listed by Corruptions.
+//
+//	Parameters (required):
+//	- Directory [string]: directory where the segment files are kept. Created if it does not exist. ex: "/var/lib/app/events"
+//
+//	Parameters (optional):
+//	- SegmentSize [int, default 10000]: maximum number of records in each segment file
+//	- User [Organization/Project struct, default nil]: Organization or Project struct used to verify the signature of stored content. Not necessary if starkbank.User was set before
+
+type Archive struct {
+	Directory   string
+	SegmentSize int
+	User        user.User
+	mutex       sync.Mutex
+	loaded      bool
+	entries     []entry
+	segment     int
+	count       int
+	ids         map[string]int
+	index       map[string]map[string][]int
+	corruptions []Corruption
+}
+
+//	Archive Record struct
+//
+//	Attributes:
+//	- Event [Event struct]: parsed Event with a typed Log
+//	- Content [string]: raw content received at the webhook endpoint or the Event encoded as JSON when it was fetched
+//	- Signature [string]: Base-64 digital signature received with the content. Empty for fetched Events
+//	- Archived [time.Time]: datetime when the record was stored
+
+type Record struct {
+	Event     Event.Event `json:"event"`
+	Content   string      `json:"content"`
+	Signature string      `json:"signature,omitempty"`
+	Archived  *time.Time  `json:"archived"`
+}
+
+//	Archive Corruption struct
+//
+//	Attributes:
+//	- Path [string]: segment file holding the unreadable record. ex: "/var/lib/app/events/events-000001.jsonl"
+//	- Offset [int64]: position of the record in the segment file, in bytes. ex: 2048
+//	- Message [string]: reason why the record could not be read
+
+type Corruption struct {
+	Path    string
+	Offset  int64
+	Message string
+}
+
+type entry struct {
+	segment int
+	offset  int64
+	length  int
+	created time.Time
+}
+
+const defaultSegmentSize = 10000
+
+const (
+	subscriptionIndex = "subscription"
+	workspaceIndex    = "workspaceId"
+	dateIndex         = "date"
+	entityIndex       = "entityId"
+)
+
+func (a *Archive) Store(event Event.Event, content string, signature string) (Record, Error.StarkErrors) {
+	//	Append an Event to the Archive
+	//
+	//	The content is verified against its signature before being stored and the archived Event is
+	//	decoded from it, so that only signed Events are replayed. Events already archived are not
+	//	stored again and their existing record is returned.
+	//
+	//	Parameters (required):
+	//	- event [Event struct]: Event parsed by dispatcher.Parse or retrieved with event.Get or event.Query. If content is given, it must have the same Id and Subscription as the content or be empty
+	//	- content [string]: raw content received at the webhook endpoint. If empty, the Event is encoded as JSON
+	//	- signature [string]: Base-64 digital signature received with the content. Required if content is not empty
+	//
+	//	Return:
+	//	- Archived Record struct
+	var parsed Event.Event
+	var err Error.StarkErrors
+	if content != "" {
+		parsed, err = verified(content, signature, a.User)
+		if err.Errors != nil {
+			return Record{}, err
+		}
+		if event.Id != "" && (event.Id != parsed.Id || event.Subscription != parsed.Subscription) {
+			return Record{}, Error.InvalidSignatureError(fmt.Sprintf("Archive event %s does not match the signed content of event %s", event.Id, parsed.Id))
+		}
+	} else if signature != "" {
+		return Record{}, Error.InvalidSignatureError("Archive signature must be stored with the content it signs")
+	} else {
+		parsed, err = event.ParseLog()
+		if err.Errors != nil {
+			return Record{}, err
+		}
+		encoded, marshalError := json.Marshal(event)
+		if marshalError != nil {
+			return Record{}, Error.UnknownError(marshalError.Error())
+		}
+		content = string(encoded)
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	err = a.load()
+	if err.Errors != nil {
+		return Record{}, err
+	}
+	if position, ok := a.ids[parsed.Id]; ok {
+		return a.read(a.entries[position])
+	}
+
+	archived := time.Now().UTC()
+	record := Record{Event: parsed, Content: content, Signature: signature, Archived: &archived}
+	line, marshalError := json.Marshal(record)
+	if marshalError != nil {
+		return Record{}, Error.UnknownError(marshalError.Error())
+	}
+
+	if a.count >= a.segmentSize() {
+		a.segment++
+		a.count = 0
+	}
+	file, openError := os.OpenFile(a.path(a.segment), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if openError != nil {
+		return Record{}, Error.UnknownError(openError.Error())
+	}
+	defer file.Close()
+	info, statError := file.Stat()
+	if statError != nil {
+		return Record{}, Error.UnknownError(statError.Error())
+	}
+	_, writeError := file.Write(append(line, '\n'))
+	if writeError != nil {
+		return Record{}, Error.UnknownError(writeError.Error())
+	}
+
+	a.add(record, entry{segment: a.segment, offset: info.Size(), length: len(line)})
+	a.count++
+	return record, Error.StarkErrors{}
+}
+
+func (a *Archive) Get(id string) (Record, Error.StarkErrors) {
+	//	Retrieve an archived Event by its id
+	//
+	//	Parameters (required):
+	//	- id [string]: Event unique id. ex: "5656565656565656"
+	//
+	//	Return:
+	//	- Archived Record struct
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	err := a.load()
+	if err.Errors != nil {
+		return Record{}, err
+	}
+	position, ok := a.ids[id]
+	if !ok {
+		return Record{}, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidEventId", Message: fmt.Sprintf("Event %s is not archived", id)}}}
+	}
+	return a.read(a.entries[position])
+}
+
+func (a *Archive) Corruptions() ([]Corruption, Error.StarkErrors) {
+	//	List the archived records that could not be read when the Archive was loaded
+	//
+	//	Return:
+	//	- Slice of Corruption structs. Empty if every record could be read
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	err := a.load()
+	if err.Errors != nil {
+		return nil, err
+	}
+	return append([]Corruption{}, a.corruptions...), Error.StarkErrors{}
+}
+
+func (a *Archive) Query(params map[string]interface{}) ([]Record, Error.StarkErrors) {
+	//	Retrieve archived Events
+	//
+	//	Records are returned sorted by Event creation datetime, oldest first. All filters are combined.
+	//
+	//	Parameters (optional):
+	//  - params [map[string]interface{}, default nil]: map of parameters for the query
+	//		- ids [slice of strings, default nil]: list of Event ids to filter by. ex: []string{"5656565656565656", "4545454545454545"}
+	//		- subscription [string, default nil]: Event subscription. ex: "transfer"
+	//		- workspaceId [string, default nil]: Id of the Workspace that generated the Events. ex: "4545454545454545"
+	//		- entityId [string, default nil]: Id of the entity to which the Event logs refer. ex: "4848484848484848"
+	//		- after [string or date.Date, default nil]: date filter for Events created on or after this date in America/Sao_Paulo. ex: "2020-04-03"
+	//		- before [string or date.Date, default nil]: date filter for Events created on or before this date in America/Sao_Paulo. ex: "2020-04-03"
+	//		- limit [int, default nil]: maximum number of Records to be retrieved. Unlimited if nil. ex: 35
+	//
+	//	Return:
+	//	- Slice of archived Record structs
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	err := a.load()
+	if err.Errors != nil {
+		return nil, err
+	}
+
+	positions := a.filter(utils.CastParams(params))
+	sort.SliceStable(positions, func(i, j int) bool {
+		return a.entries[positions[i]].created.Before(a.entries[positions[j]].created)
+	})
+	if limit, ok := params["limit"].(int); ok && limit >= 0 && limit < len(positions) {
+		positions = positions[:limit]
+	}
+
+	records := make([]Record, 0, len(positions))
+	for _, position := range positions {
+		record, err := a.read(a.entries[position])
+		if err.Errors != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, Error.StarkErrors{}
+}
+
+func (a *Archive) Replay(params map[string]interface{}, d *dispatcher.Dispatcher) (int, Error.StarkErrors) {
+	//	Feed archived Events into a Dispatcher
+	//
+	//	Events are dispatched oldest first and are not set as delivered in the Stark Bank API.
+	//	Events the Dispatcher handled recently or is handling elsewhere are skipped.
+	//
+	//	Parameters (required):
+	//	- params [map[string]interface{}]: same parameters accepted by Query
+	//	- d [Dispatcher pointer]: Dispatcher with the handlers to be called
+	//
+	//	Return:
+	//	- number of Events handled successfully
+	records, err := a.Query(params)
+	if err.Errors != nil {
+		return 0, err
+	}
+
+	handled := 0
+	var errors []Error.StarkError
+	for _, record := range records {
+		dispatchError := d.Dispatch(record.Event)
+		if dispatchError == dispatcher.ErrInFlight {
+			continue
+		}
+		if dispatchError != nil {
+			errors = append(errors, Error.StarkError{
+				Code:    "handlerError",
+				Message: fmt.Sprintf("event %s: %s", record.Event.Id, dispatchError.Error()),
+			})
+			continue
+		}
+		handled++
+	}
+	return handled, Error.StarkErrors{Errors: errors}
+}
+
+func (a *Archive) filter(params map[string]interface{}) []int {
+	var candidates []int
+	filtered := false
+	restrict := func(positions []int) {
+		if !filtered {
+			candidates = append([]int{}, positions...)
+			filtered = true
+			return
+		}
+		allowed := map[int]bool{}
+		for _, position := range positions {
+			allowed[position] = true
+		}
+		var kept []int
+		for _, position := range candidates {
+			if allowed[position] {
+				kept = append(kept, position)
+			}
+		}
+		candidates = kept
+	}
+
+	if ids, ok := params["ids"].([]string); ok {
+		var positions []int
+		for _, id := range ids {
+			if position, ok := a.ids[id]; ok {
+				positions = append(positions, position)
+			}
+		}
+		restrict(positions)
+	}
+	for _, name := range []string{subscriptionIndex, workspaceIndex, entityIndex} {
+		if value, ok := params[name].(string); ok && value != "" {
+			restrict(a.index[name][value])
+		}
+	}
+
+	after, hasAfter := params["after"].(string)
+	before, hasBefore := params["before"].(string)
+	if hasAfter || hasBefore {
+		var positions []int
+		for date, datePositions := range a.index[dateIndex] {
+			if (hasAfter && date < after) || (hasBefore && date > before) {
+				continue
+			}
+			positions = append(positions, datePositions...)
+		}
+		restrict(positions)
+	}
+
+	if !filtered {
+		candidates = make([]int, len(a.entries))
+		for i := range candidates {
+			candidates[i] = i
+		}
+	}
+	return candidates
+}
+
+func (a *Archive) load() Error.StarkErrors {
+	if a.loaded {
+		return Error.StarkErrors{}
+	}
+	if a.Directory == "" {
+		return Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidDirectory", Message: "Archive directory must not be empty"}}}
+	}
+	mkdirError := os.MkdirAll(a.Directory, 0700)
+	if mkdirError != nil {
+		return Error.UnknownError(mkdirError.Error())
+	}
+
+	a.entries = nil
+	a.corruptions = nil
+	a.ids = map[string]int{}
+	a.index = map[string]map[string][]int{}
+	a.segment = 1
+	a.count = 0
+	for segment := 1; ; segment++ {
+		file, openError := os.Open(a.path(segment))
+		if os.IsNotExist(openError) {
+			break
+		}
+		if openError != nil {
+			return Error.UnknownError(openError.Error())
+		}
+		count, err := a.scan(file, segment)
+		file.Close()
+		if err.Errors != nil {
+			return err
+		}
+		a.segment = segment
+		a.count = count
+	}
+	a.loaded = true
+	return Error.StarkErrors{}
+}
+
+func (a *Archive) scan(file *os.File, segment int) (int, Error.StarkErrors) {
+	count := 0
+	var offset int64
+	reader := bufio.NewReader(file)
+	for {
+		line, readError := reader.ReadBytes('\n')
+		if readError == io.EOF && len(line) == 0 {
+			return count, Error.StarkErrors{}
+		}
+		if readError == io.EOF {
+			truncateError := os.Truncate(a.path(segment), offset)
+			if truncateError != nil {
+				return count, Error.UnknownError(truncateError.Error())
+			}
+			return count, Error.StarkErrors{}
+		}
+		if readError != nil {
+			return count, Error.UnknownError(readError.Error())
+		}
+
+		length := len(line) - 1
+		record, err := decode(line[:length])
+		if err.Errors != nil {
+			a.corruptions = append(a.corruptions, Corruption{Path: a.path(segment), Offset: offset, Message: err.Errors[0].Message})
+		} else if _, ok := a.ids[record.Event.Id]; !ok {
+			a.add(record, entry{segment: segment, offset: offset, length: length})
+		}
+		offset += int64(len(line))
+		count++
+	}
+}
+
+func (a *Archive) add(record Record, e entry) {
+	if record.Event.Created != nil {
+		e.created = *record.Event.Created
+	}
+	position := len(a.entries)
+	a.entries = append(a.entries, e)
+	a.ids[record.Event.Id] = position
+
+	a.insert(subscriptionIndex, record.Event.Subscription, position)
+	a.insert(workspaceIndex, record.Event.WorkspaceId, position)
+	if record.Event.Created != nil {
+		a.insert(dateIndex, record.Event.Created.In(Date.Location).Format(Date.Layout), position)
+	}
+	if log, ok := record.Event.ParsedLog(); ok {
+		a.insert(entityIndex, log.EntityId(), position)
+	}
+}
+
+func (a *Archive) insert(name string, value string, position int) {
+	if value == "" {
+		return
+	}
+	if a.index[name] == nil {
+		a.index[name] = map[string][]int{}
+	}
+	a.index[name][value] = append(a.index[name][value], position)
+}
+
+func (a *Archive) read(e entry) (Record, Error.StarkErrors) {
+	file, openError := os.Open(a.path(e.segment))
+	if openError != nil {
+		return Record{}, Error.UnknownError(openError.Error())
+	}
+	defer file.Close()
+
+	line := make([]byte, e.length)
+	_, readError := file.ReadAt(line, e.offset)
+	if readError != nil {
+		return Record{}, Error.UnknownError(readError.Error())
+	}
+	return decode(line)
+}
+
+func (a *Archive) segmentSize() int {
+	if a.SegmentSize <= 0 {
+		return defaultSegmentSize
+	}
+	return a.SegmentSize
+}
+
+func (a *Archive) path(segment int) string {
+	return filepath.Join(a.Directory, fmt.Sprintf("events-%06d.jsonl", segment))
+}
+
+func verified(content string, signature string, user user.User) (Event.Event, Error.StarkErrors) {
+	var envelope struct {
+		Event Event.Event
+	}
+	verifiedContent, err := utils.Verify(content, signature, user)
+	if err.Errors != nil {
+		return envelope.Event, err
+	}
+	unmarshalError := json.Unmarshal([]byte(verifiedContent), &envelope)
+	if unmarshalError != nil {
+		return envelope.Event, Error.UnknownError(unmarshalError.Error())
+	}
+	return envelope.Event.ParseLog()
+}
+
+func decode(line []byte) (Record, Error.StarkErrors) {
+	var record Record
+	unmarshalError := json.Unmarshal(line, &record)
+	if unmarshalError != nil {
+		return record, Error.UnknownError(unmarshalError.Error())
+	}
+	event, err := record.Event.ParseLog()
+	if err.Errors != nil {
+		return record, err
+	}
+	record.Event = event
+	return record, Error.StarkErrors{}
+}
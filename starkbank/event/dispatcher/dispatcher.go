@@ -0,0 +1,356 @@
+package dispatcher
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	BoletoLog "github.com/starkbank/sdk-go/starkbank/boleto/log"
+	HolmesLog "github.com/starkbank/sdk-go/starkbank/boletoholmes/log"
+	BoletoPaymentLog "github.com/starkbank/sdk-go/starkbank/boletopayment/log"
+	BrcodePaymentLog "github.com/starkbank/sdk-go/starkbank/brcodepayment/log"
+	DarfPaymentLog "github.com/starkbank/sdk-go/starkbank/darfpayment/log"
+	DepositLog "github.com/starkbank/sdk-go/starkbank/deposit/log"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	InvoiceLog "github.com/starkbank/sdk-go/starkbank/invoice/log"
+	InvoicePullRequestLog "github.com/starkbank/sdk-go/starkbank/invoicepullrequest/log"
+	InvoicePullSubscriptionLog "github.com/starkbank/sdk-go/starkbank/invoicepullsubscription/log"
+	TaxPaymentLog "github.com/starkbank/sdk-go/starkbank/taxpayment/log"
+	TransferLog "github.com/starkbank/sdk-go/starkbank/transfer/log"
+	UtilityPaymentLog "github.com/starkbank/sdk-go/starkbank/utilitypayment/log"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+//	Event Dispatcher struct
+//
+//	A Dispatcher routes Events to the typed handlers registered for each subscription.
+//	It can be mounted as an http.Handler on your webhook endpoint and also be fed by
+//	the event Poller, so both delivery paths share the same handlers. An Event that is
+//	being handled is never handed to a second handler call at the same time, and Events
+//	handled recently are skipped, so both paths may run side by side.
+//
+//	Parameters (optional):
+//	- User [Organization/Project struct, default nil]: Organization or Project struct used to verify webhook signatures. Not necessary if starkbank.User was set before
+//	- History [int, default 10000]: number of recently handled Event ids remembered to skip duplicated deliveries
+
+type Dispatcher struct {
+	User     user.User
+	History  int
+	mutex    sync.Mutex
+	handlers map[string]func(Event.Event) error
+	fallback func(Event.Event) error
+	inFlight map[string]bool
+	handled  map[string]bool
+	order    []string
+}
+
+// ErrInFlight is returned by Dispatch when the same Event is already being handled by another call.
+var ErrInFlight = errors.New("event is already being handled")
+
+// ErrNoHandler is returned by Dispatch when no handler is registered for the Event subscription and OnOther was not called.
+var ErrNoHandler = errors.New("no handler is registered for the event subscription")
+
+const defaultHistory = 10000
+
+func (d *Dispatcher) OnTransfer(handler func(Event.Event, TransferLog.Log) error) {
+	//	Register the handler called for "transfer" Events
+	d.register("transfer", func(event Event.Event) error {
+		log, ok := event.Log.(TransferLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnInvoice(handler func(Event.Event, InvoiceLog.Log) error) {
+	//	Register the handler called for "invoice" Events
+	d.register("invoice", func(event Event.Event) error {
+		log, ok := event.Log.(InvoiceLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnDeposit(handler func(Event.Event, DepositLog.Log) error) {
+	//	Register the handler called for "deposit" Events
+	d.register("deposit", func(event Event.Event) error {
+		log, ok := event.Log.(DepositLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnBoleto(handler func(Event.Event, BoletoLog.Log) error) {
+	//	Register the handler called for "boleto" Events
+	d.register("boleto", func(event Event.Event) error {
+		log, ok := event.Log.(BoletoLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnBoletoHolmes(handler func(Event.Event, HolmesLog.Log) error) {
+	//	Register the handler called for "boleto-holmes" Events
+	d.register("boleto-holmes", func(event Event.Event) error {
+		log, ok := event.Log.(HolmesLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnBoletoPayment(handler func(Event.Event, BoletoPaymentLog.Log) error) {
+	//	Register the handler called for "boleto-payment" Events
+	d.register("boleto-payment", func(event Event.Event) error {
+		log, ok := event.Log.(BoletoPaymentLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnBrcodePayment(handler func(Event.Event, BrcodePaymentLog.Log) error) {
+	//	Register the handler called for "brcode-payment" Events
+	d.register("brcode-payment", func(event Event.Event) error {
+		log, ok := event.Log.(BrcodePaymentLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnDarfPayment(handler func(Event.Event, DarfPaymentLog.Log) error) {
+	//	Register the handler called for "darf-payment" Events
+	d.register("darf-payment", func(event Event.Event) error {
+		log, ok := event.Log.(DarfPaymentLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnTaxPayment(handler func(Event.Event, TaxPaymentLog.Log) error) {
+	//	Register the handler called for "tax-payment" Events
+	d.register("tax-payment", func(event Event.Event) error {
+		log, ok := event.Log.(TaxPaymentLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnUtilityPayment(handler func(Event.Event, UtilityPaymentLog.Log) error) {
+	//	Register the handler called for "utility-payment" Events
+	d.register("utility-payment", func(event Event.Event) error {
+		log, ok := event.Log.(UtilityPaymentLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnInvoicePullSubscription(handler func(Event.Event, InvoicePullSubscriptionLog.Log) error) {
+	//	Register the handler called for "invoice-pull-subscription" Events
+	d.register("invoice-pull-subscription", func(event Event.Event) error {
+		log, ok := event.Log.(InvoicePullSubscriptionLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnInvoicePullRequest(handler func(Event.Event, InvoicePullRequestLog.Log) error) {
+	//	Register the handler called for "invoice-pull-request" Events
+	d.register("invoice-pull-request", func(event Event.Event) error {
+		log, ok := event.Log.(InvoicePullRequestLog.Log)
+		if !ok {
+			return unexpectedLog(event)
+		}
+		return handler(event, log)
+	})
+}
+
+func (d *Dispatcher) OnOther(handler func(Event.Event) error) {
+	//	Register a handler for Events whose subscription has no typed handler.
+	//	Without it, such Events are returned with ErrNoHandler and are not set as delivered.
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.fallback = handler
+}
+
+func (d *Dispatcher) Dispatch(event Event.Event) error {
+	//	Handle a single Event
+	//
+	//	Parse the Event log if necessary and call the handler registered for its subscription.
+	//	Events handled successfully in the recent history are skipped.
+	//
+	//	Parameters (required):
+	//	- event [Event struct]: Event received at the webhook endpoint or retrieved from the Stark Bank API
+	//
+	//	Return:
+	//	- ErrInFlight if the Event is being handled by another call, ErrNoHandler if no handler matches it, the handler error or nil on success
+	return d.dispatch(event, false)
+}
+
+func (d *Dispatcher) Redispatch(event Event.Event) error {
+	//	Handle a single Event again
+	//
+	//	Same as Dispatch, but the handler is called even if the Event was handled recently.
+	//	Useful to reprocess past Events after fixing a handler.
+	//
+	//	Parameters (required):
+	//	- event [Event struct]: Event received at the webhook endpoint or retrieved from the Stark Bank API
+	//
+	//	Return:
+	//	- ErrInFlight if the Event is being handled by another call, ErrNoHandler if no handler matches it, the handler error or nil on success
+	return d.dispatch(event, true)
+}
+
+func (d *Dispatcher) dispatch(event Event.Event, force bool) error {
+	if _, ok := event.Log.(map[string]interface{}); ok {
+		parsed, err := event.ParseLog()
+		if err.Errors != nil {
+			return fmt.Errorf("event %s: %s", event.Id, err.Errors[0].Message)
+		}
+		event = parsed
+	}
+
+	handler, claimError := d.claim(event, force)
+	if claimError != nil || handler == nil {
+		return claimError
+	}
+
+	handlerError := handler(event)
+	d.release(event.Id, handlerError == nil)
+	return handlerError
+}
+
+func (d *Dispatcher) Parse(content string, signature string) (Event.Event, Error.StarkErrors) {
+	//	Create a single Event from a webhook request content
+	//
+	//	Verify the content against its digital signature and parse it into an Event struct with a typed Log.
+	//
+	//	Parameters (required):
+	//	- content [string]: Response content from request received at user endpoint (not parsed)
+	//	- signature [string]: Base-64 digital signature received at response header "Digital-Signature"
+	//
+	//	Return:
+	//	- Parsed Event struct
+	var envelope struct {
+		Event Event.Event
+	}
+	parsed, err := Event.Parse(content, signature, d.User)
+	if err.Errors != nil {
+		return envelope.Event, err
+	}
+
+	unmarshalError := json.Unmarshal([]byte(parsed.(string)), &envelope)
+	if unmarshalError != nil {
+		return envelope.Event, Error.UnknownError(unmarshalError.Error())
+	}
+	return envelope.Event.ParseLog()
+}
+
+func (d *Dispatcher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	//	Receive an Event posted to the webhook endpoint
+	//
+	//	Answers 200 when the Event was handled, 400 when it could not be verified, 409 when it is
+	//	already being handled elsewhere and 500 when the handler fails or is missing, so that Stark Bank retries it.
+	content, readError := ioutil.ReadAll(r.Body)
+	if readError != nil {
+		http.Error(w, readError.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := d.Parse(string(content), r.Header.Get("Digital-Signature"))
+	if err.Errors != nil {
+		http.Error(w, err.Errors[0].Message, http.StatusBadRequest)
+		return
+	}
+
+	dispatchError := d.Dispatch(event)
+	if dispatchError == ErrInFlight {
+		http.Error(w, dispatchError.Error(), http.StatusConflict)
+		return
+	}
+	if dispatchError != nil {
+		http.Error(w, dispatchError.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (d *Dispatcher) register(subscription string, handler func(Event.Event) error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.handlers == nil {
+		d.handlers = map[string]func(Event.Event) error{}
+	}
+	d.handlers[subscription] = handler
+}
+
+func (d *Dispatcher) claim(event Event.Event, force bool) (func(Event.Event) error, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.inFlight == nil {
+		d.inFlight = map[string]bool{}
+		d.handled = map[string]bool{}
+	}
+	if d.inFlight[event.Id] {
+		return nil, ErrInFlight
+	}
+	if d.handled[event.Id] && !force {
+		return nil, nil
+	}
+
+	handler, ok := d.handlers[event.Subscription]
+	if !ok {
+		handler = d.fallback
+	}
+	if handler == nil {
+		return nil, ErrNoHandler
+	}
+	d.inFlight[event.Id] = true
+	return handler, nil
+}
+
+func (d *Dispatcher) release(id string, success bool) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	delete(d.inFlight, id)
+	if !success || d.handled[id] {
+		return
+	}
+
+	history := d.History
+	if history <= 0 {
+		history = defaultHistory
+	}
+	d.handled[id] = true
+	d.order = append(d.order, id)
+	for len(d.order) > history {
+		delete(d.handled, d.order[0])
+		d.order = d.order[1:]
+	}
+}
+
+func unexpectedLog(event Event.Event) error {
+	return fmt.Errorf("event %s: unexpected log type %T for subscription %s", event.Id, event.Log, event.Subscription)
+}
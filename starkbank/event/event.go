@@ -28,7 +28,7 @@ import (
 //
 //	Attributes (return-only):
 //	- Id [string]: Unique id returned when the event is created. ex: "5656565656565656"
-//	- Log [Log]: A Log struct from one of the subscribed services (TransferLog, InvoiceLog, DepositLog, BoletoLog, BoletoHolmesLog, BrcodePaymentLog, BoletoPaymentLog, UtilityPaymentLog, TaxPaymentLog or DarfPaymentLog)
+//	- Log [Log]: A Log struct from one of the subscribed services (TransferLog, InvoiceLog, DepositLog, BoletoLog, BoletoHolmesLog, BrcodePaymentLog, BoletoPaymentLog, UtilityPaymentLog, TaxPaymentLog or DarfPaymentLog). Use ParsedLog to access it through the common Log interface
 //	- Created [string]: Creation datetime for the notification event. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
 //	- IsDelivered [bool]: True if the event has been successfully delivered to the user url. ex: False
 //	- Subscription [string]: Service that triggered this event. ex: "transfer", "utility-payment"
@@ -45,6 +45,22 @@ type Event struct {
 
 var resource = map[string]string{"name": "Event"}
 
+//	Subscriptions whose Event logs are parsed by ParseLog into typed Log structs
+var Subscriptions = []string{
+	"boleto",
+	"boleto-holmes",
+	"boleto-payment",
+	"brcode-payment",
+	"darf-payment",
+	"deposit",
+	"invoice",
+	"invoice-pull-request",
+	"invoice-pull-subscription",
+	"tax-payment",
+	"transfer",
+	"utility-payment",
+}
+
 func Get(id string, user user.User) (Event, Error.StarkErrors) {
 	//	Retrieve a specific notification Event by its id
 	//
@@ -84,9 +100,10 @@ func Query(params map[string]interface{}, user user.User) (chan Event, chan Erro
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- isDelivered [bool, default nil]: Bool to filter successfully delivered events. ex: True or False
+	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -129,8 +146,8 @@ func Page(params map[string]interface{}, user user.User) ([]Event, string, Error
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- isDelivered [bool, default nil]: Bool to filter successfully delivered events. ex: True or False
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
@@ -237,7 +254,7 @@ func Parse(content string, signature string, user user.User) (interface{}, Error
 	//
 	//	Return:
 	//	- Parsed Event struct
-	return utils.ParseAndVerify(content, signature, "event", user)
+	return utils.ParseAndVerify(content, signature, user)
 }
 
 func (e Event) ParseLog() (Event, Error.StarkErrors) {
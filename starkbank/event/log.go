@@ -0,0 +1,29 @@
+package event
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	Log interface
+//
+//	Implemented by the Log struct of every resource. Defined in utils, where each log package asserts
+//	that its Log struct implements it. See utils.Log for its methods.
+
+type Log = utils.Log
+
+func (e Event) ParsedLog() (Log, bool) {
+	//	Retrieve the Event log through the common Log interface
+	//
+	//	Return:
+	//	- Log of the Event, parsing it first if necessary
+	//	- false if the Event log could not be parsed into a typed Log struct
+	if _, ok := e.Log.(map[string]interface{}); ok {
+		parsed, err := e.ParseLog()
+		if err.Errors != nil {
+			return nil, false
+		}
+		e = parsed
+	}
+	log, ok := e.Log.(Log)
+	return log, ok
+}
@@ -0,0 +1,141 @@
+package poller
+
+import (
+	"context"
+	"fmt"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	"github.com/starkbank/sdk-go/starkbank/event/dispatcher"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"time"
+)
+
+//	Event Poller struct
+//
+//	A Poller is a fallback for periods when your webhook endpoint is unreachable.
+//	On every run it queries the Events that were not delivered yet, feeds them to the
+//	same Dispatcher used by your webhook endpoint and sets each Event as delivered
+//	only after its handler succeeds. Events without a matching handler are left undelivered.
+//	Failed runs are retried with exponential backoff.
+//
+//	Parameters (required):
+//	- Dispatcher [*Dispatcher]: Dispatcher holding the handlers shared with your webhook endpoint
+//
+//	Parameters (optional):
+//	- Interval [time.Duration, default 1 minute]: time to wait between successful runs. ex: 30 * time.Second
+//	- MaxBackoff [time.Duration, default 30 minutes]: maximum time to wait between runs after consecutive failures. ex: 10 * time.Minute
+//	- Params [map[string]interface{}, default nil]: additional Event query filters. ex: map[string]interface{}{"after": "2022-11-10"}
+//	- OnError [func(Error.StarkErrors), default nil]: function called with the errors of each failed run
+//	- User [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before
+
+type Poller struct {
+	Dispatcher *dispatcher.Dispatcher
+	Interval   time.Duration
+	MaxBackoff time.Duration
+	Params     map[string]interface{}
+	OnError    func(Error.StarkErrors)
+	User       user.User
+}
+
+const defaultInterval = time.Minute
+const defaultMaxBackoff = 30 * time.Minute
+
+func (p *Poller) Poll() (int, Error.StarkErrors) {
+	//	Drain undelivered Events once
+	//
+	//	Query the Events that have not been delivered yet, dispatch each of them and
+	//	set the ones whose handlers succeeded as delivered. Events being handled by the
+	//	webhook endpoint at the same time are left for it, and Events with no matching
+	//	handler are left undelivered.
+	//
+	//	Return:
+	//	- Number of Events handled and set as delivered
+	//	- Errors found while querying, handling or updating Events
+	var errors []Error.StarkError
+	delivered := 0
+
+	params := map[string]interface{}{}
+	for key, value := range p.Params {
+		params[key] = value
+	}
+	params["isDelivered"] = false
+
+	events, errorChannel := Event.Query(params, p.User)
+	loop:
+	for {
+		select {
+		case err := <-errorChannel:
+			errors = append(errors, err.Errors...)
+		case event, ok := <-events:
+			if !ok {
+				break loop
+			}
+			dispatchError := p.Dispatcher.Dispatch(event)
+			if dispatchError == dispatcher.ErrInFlight || dispatchError == dispatcher.ErrNoHandler {
+				continue
+			}
+			if dispatchError != nil {
+				errors = append(errors, Error.StarkError{
+					Code:    "handlerError",
+					Message: fmt.Sprintf("event %s: %s", event.Id, dispatchError.Error()),
+				})
+				continue
+			}
+			_, err := Event.Update(event.Id, map[string]interface{}{"isDelivered": true}, p.User)
+			if err.Errors != nil {
+				errors = append(errors, err.Errors...)
+				continue
+			}
+			delivered++
+		}
+	}
+	return delivered, Error.StarkErrors{Errors: errors}
+}
+
+func (p *Poller) Run(ctx context.Context) {
+	//	Drain undelivered Events on an interval
+	//
+	//	Call Poll until the context is canceled, waiting Interval between successful runs
+	//	and doubling the wait after each failed run, up to MaxBackoff.
+	//
+	//	Parameters (required):
+	//	- ctx [context.Context]: context that stops the Poller when canceled
+	interval := p.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	if maxBackoff < interval {
+		maxBackoff = interval
+	}
+
+	wait := interval
+	for {
+		_, err := p.Poll()
+		if err.Errors == nil {
+			wait = interval
+		} else {
+			if p.OnError != nil {
+				p.OnError(err)
+			}
+			wait = backoff(wait, maxBackoff)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+func backoff(wait time.Duration, maxBackoff time.Duration) time.Duration {
+	wait *= 2
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+	return wait
+}
@@ -0,0 +1,169 @@
+package replay
+
+import (
+	"fmt"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	"github.com/starkbank/sdk-go/starkbank/event/dispatcher"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"sort"
+)
+
+//	Event Replayer struct
+//
+//	A Replayer fetches past Events from the Stark Bank API and feeds them to the handlers
+//	registered in a Dispatcher, such as after a handler bug is fixed. Events are handled
+//	oldest first and even if the Dispatcher handled them recently.
+//
+//	Parameters (required):
+//	- Dispatcher [Dispatcher pointer]: Dispatcher with the handlers to be called
+//
+//	Parameters (optional):
+//	- DryRun [bool, default false]: if true, Events are fetched and matched but no handler is called and nothing is updated
+//	- KeepUndelivered [bool, default false]: if true, undelivered Events are not set as delivered after being handled
+//	- User [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before
+
+type Replayer struct {
+	Dispatcher      *dispatcher.Dispatcher
+	DryRun          bool
+	KeepUndelivered bool
+	User            user.User
+}
+
+//	Replay Result struct
+//
+//	Attributes:
+//	- Events [slice of Event structs]: Events matched by the replay, oldest first, with typed Logs
+//	- Handled [slice of strings]: ids of the Events whose handlers succeeded. Empty on dry runs
+//	- Delivered [slice of strings]: ids of the Events set as delivered after being handled
+//	- Skipped [slice of strings]: ids of the Events not handled because they were being handled concurrently or had no handler
+
+type Result struct {
+	Events    []Event.Event
+	Handled   []string
+	Delivered []string
+	Skipped   []string
+}
+
+func (r *Replayer) Run(params map[string]interface{}) (Result, Error.StarkErrors) {
+	//	Replay past Events
+	//
+	//	Fetch Events by id with event.Get or by date with event.Query, decode their logs with
+	//	ParseLog and call the Dispatcher handlers. A handler failure does not stop the replay
+	//	and is reported with the "handlerError" code. Events being handled concurrently or
+	//	without a handler are listed as skipped and are not set as delivered.
+	//
+	//	Parameters (optional):
+	//  - params [map[string]interface{}, default nil]: map of parameters for the replay
+	//		- ids [slice of strings, default nil]: Event ids to be replayed. If given, the date filters are ignored. ex: []string{"5656565656565656", "4545454545454545"}
+	//		- after [string or date.Date, default nil]: date filter for Events created after this date. ex: "2020-04-03"
+	//		- before [string or date.Date, default nil]: date filter for Events created before this date. ex: "2020-04-03"
+	//		- isDelivered [bool, default nil]: bool to filter delivered or undelivered Events. ex: false
+	//		- entityId [string, default nil]: replay only Events whose log refers to this entity. Events are filtered after being fetched, so after or before is required unless ids are given. ex: "4848484848484848"
+	//		- limit [int, default nil]: maximum number of Events to be fetched. ex: 35
+	//
+	//	Return:
+	//	- Result struct
+	entityId, _ := params["entityId"].(string)
+	if _, ok := params["ids"].([]string); entityId != "" && !ok && params["after"] == nil && params["before"] == nil {
+		return Result{}, Error.StarkErrors{Errors: []Error.StarkError{{
+			Code:    "invalidReplayParams",
+			Message: "Replay by entityId requires an after or before date, since Events are filtered after being fetched",
+		}}}
+	}
+
+	events, err := r.fetch(params)
+	if err.Errors != nil {
+		return Result{}, err
+	}
+
+	var result Result
+	for _, event := range events {
+		parsed, err := event.ParseLog()
+		if err.Errors != nil {
+			return Result{}, err
+		}
+		if entityId != "" {
+			log, ok := parsed.ParsedLog()
+			if !ok || log.EntityId() != entityId {
+				continue
+			}
+		}
+		result.Events = append(result.Events, parsed)
+	}
+	sort.SliceStable(result.Events, func(i, j int) bool {
+		a, b := result.Events[i].Created, result.Events[j].Created
+		return a != nil && b != nil && a.Before(*b)
+	})
+	if r.DryRun {
+		return result, Error.StarkErrors{}
+	}
+
+	var errors []Error.StarkError
+	for _, event := range result.Events {
+		dispatchError := r.Dispatcher.Redispatch(event)
+		if dispatchError == dispatcher.ErrInFlight || dispatchError == dispatcher.ErrNoHandler {
+			result.Skipped = append(result.Skipped, event.Id)
+			continue
+		}
+		if dispatchError != nil {
+			errors = append(errors, Error.StarkError{
+				Code:    "handlerError",
+				Message: fmt.Sprintf("event %s: %s", event.Id, dispatchError.Error()),
+			})
+			continue
+		}
+		result.Handled = append(result.Handled, event.Id)
+
+		if r.KeepUndelivered || event.IsDelivered {
+			continue
+		}
+		_, err := Event.Update(event.Id, map[string]interface{}{"isDelivered": true}, r.User)
+		if err.Errors != nil {
+			errors = append(errors, err.Errors...)
+			continue
+		}
+		result.Delivered = append(result.Delivered, event.Id)
+	}
+	return result, Error.StarkErrors{Errors: errors}
+}
+
+func (r *Replayer) fetch(params map[string]interface{}) ([]Event.Event, Error.StarkErrors) {
+	var events []Event.Event
+	if ids, ok := params["ids"].([]string); ok {
+		for _, id := range ids {
+			event, err := Event.Get(id, r.User)
+			if err.Errors != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		}
+		return events, Error.StarkErrors{}
+	}
+
+	query := map[string]interface{}{}
+	for _, key := range []string{"after", "before", "isDelivered", "limit"} {
+		if value, ok := params[key]; ok {
+			query[key] = value
+		}
+	}
+
+	var errors []Error.StarkError
+	eventChannel, errorChannel := Event.Query(query, r.User)
+	loop:
+	for {
+		select {
+		case err := <-errorChannel:
+			errors = append(errors, err.Errors...)
+		case event, ok := <-eventChannel:
+			if !ok {
+				break loop
+			}
+			events = append(events, event)
+		}
+	}
+	if errors != nil {
+		return nil, Error.StarkErrors{Errors: errors}
+	}
+	return events, Error.StarkErrors{}
+}
@@ -0,0 +1,255 @@
+package sequencer
+
+import (
+	"fmt"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"sync"
+	"time"
+)
+
+//	Event Sequencer struct
+//
+//	Webhook Events for the same entity may arrive out of order, ex: "success" before "processing".
+//	A Sequencer remembers the latest applied log of each entity and tells whether a new Event
+//	is newer than it. Logs are ordered by their creation datetime and, when it ties, by the
+//	precedence of their types in the entity lifecycle.
+//
+//	Usage:
+//	- Call Check before handling an Event and skip it if Apply is false
+//	- Call Commit after handling it, so that older Events are recognized as stale
+
+type Sequencer struct {
+	mutex  sync.Mutex
+	latest map[string]State
+}
+
+//	State struct
+//
+//	Attributes:
+//	- Subscription [string]: Event subscription of the entity. ex: "transfer"
+//	- EntityId [string]: Id of the entity to which the logs refer. ex: "5656565656565656"
+//	- LogId [string]: Id of the latest applied log. ex: "4848484848484848"
+//	- Type [string]: type of the latest applied log. ex: "processing"
+//	- Created [time.Time]: creation datetime of the latest applied log
+
+type State struct {
+	Subscription string
+	EntityId     string
+	LogId        string
+	Type         string
+	Created      *time.Time
+}
+
+//	Result struct
+//
+//	Attributes:
+//	- Apply [bool]: true if the Event is newer than the latest applied log and should be handled
+//	- Duplicate [bool]: true if the Event carries the latest applied log itself
+//	- Previous [State struct pointer]: latest applied log before this Event. nil for new entities
+//	- Current [State struct]: log carried by the Event
+//	- Skipped [slice of strings]: lifecycle states expected between Previous and Current that were never applied. ex: []string{"processing"}
+
+type Result struct {
+	Apply     bool
+	Duplicate bool
+	Previous  *State
+	Current   State
+	Skipped   []string
+}
+
+type stage struct {
+	rank        int
+	predecessor string
+}
+
+var paymentLifecycle = map[string]stage{
+	"created":    {0, ""},
+	"processing": {1, "created"},
+	"success":    {2, "processing"},
+	"failed":     {2, "processing"},
+	"canceled":   {2, "created"},
+}
+
+var lifecycles = map[string]map[string]stage{
+	"transfer":        paymentLifecycle,
+	"boleto-payment":  paymentLifecycle,
+	"brcode-payment":  paymentLifecycle,
+	"darf-payment":    paymentLifecycle,
+	"tax-payment":     paymentLifecycle,
+	"utility-payment": paymentLifecycle,
+	"invoice": {
+		"created":    {0, ""},
+		"registered": {1, "created"},
+		"updated":    {2, ""},
+		"overdue":    {3, "registered"},
+		"paid":       {4, "registered"},
+		"canceled":   {4, "created"},
+		"credited":   {5, "paid"},
+		"expired":    {5, "overdue"},
+		"reversed":   {6, "credited"},
+		"voided":     {6, ""},
+	},
+	"boleto": {
+		"created":    {0, ""},
+		"failed":     {1, "created"},
+		"registered": {1, "created"},
+		"updated":    {2, ""},
+		"overdue":    {3, "registered"},
+		"paid":       {4, "registered"},
+		"canceled":   {4, "created"},
+		"credited":   {5, "paid"},
+	},
+	"boleto-holmes": {
+		"solving": {0, ""},
+		"solved":  {1, "solving"},
+		"failed":  {1, "solving"},
+	},
+	"deposit": {
+		"created":  {0, ""},
+		"credited": {1, "created"},
+		"refunded": {2, ""},
+	},
+	"invoice-pull-subscription": {
+		"created":  {0, ""},
+		"active":   {1, "created"},
+		"canceled": {2, ""},
+		"expired":  {2, ""},
+	},
+	"invoice-pull-request": {
+		"created":   {0, ""},
+		"pending":   {1, "created"},
+		"scheduled": {2, "pending"},
+		"success":   {3, "scheduled"},
+		"failed":    {3, ""},
+		"canceled":  {3, ""},
+	},
+}
+
+func (s *Sequencer) Check(event Event.Event) (Result, Error.StarkErrors) {
+	//	Compare an Event with the latest applied log of its entity without recording it
+	//
+	//	Parameters (required):
+	//	- event [Event struct]: Event with a parsed Log
+	//
+	//	Return:
+	//	- Result struct
+	current, err := describe(event)
+	if err.Errors != nil {
+		return Result{}, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.compare(current), Error.StarkErrors{}
+}
+
+func (s *Sequencer) Commit(event Event.Event) (Result, Error.StarkErrors) {
+	//	Record an Event as the latest applied log of its entity if it is newer than the current one
+	//
+	//	Parameters (required):
+	//	- event [Event struct]: Event with a parsed Log
+	//
+	//	Return:
+	//	- Result struct. The Event was recorded if Apply is true
+	current, err := describe(event)
+	if err.Errors != nil {
+		return Result{}, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	result := s.compare(current)
+	if result.Apply {
+		if s.latest == nil {
+			s.latest = map[string]State{}
+		}
+		s.latest[key(current.Subscription, current.EntityId)] = current
+	}
+	return result, Error.StarkErrors{}
+}
+
+func (s *Sequencer) Latest(subscription string, entityId string) (State, bool) {
+	//	Retrieve the latest applied log of an entity
+	//
+	//	Parameters (required):
+	//	- subscription [string]: Event subscription of the entity. ex: "transfer"
+	//	- entityId [string]: Id of the entity. ex: "5656565656565656"
+	//
+	//	Return:
+	//	- State struct and true if any log of the entity was committed
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	state, ok := s.latest[key(subscription, entityId)]
+	return state, ok
+}
+
+func (s *Sequencer) compare(current State) Result {
+	result := Result{Current: current}
+	previous, ok := s.latest[key(current.Subscription, current.EntityId)]
+	if !ok {
+		result.Apply = true
+		return result
+	}
+	result.Previous = &previous
+
+	if previous.LogId == current.LogId {
+		result.Duplicate = true
+		return result
+	}
+	if !isNewer(current, previous) {
+		return result
+	}
+	result.Apply = true
+	result.Skipped = skipped(current, previous)
+	return result
+}
+
+func isNewer(current State, previous State) bool {
+	if current.Created != nil && previous.Created != nil && !current.Created.Equal(*previous.Created) {
+		return current.Created.After(*previous.Created)
+	}
+	return rank(current) > rank(previous)
+}
+
+func rank(state State) int {
+	stage, ok := lifecycles[state.Subscription][state.Type]
+	if !ok {
+		return -1
+	}
+	return stage.rank
+}
+
+func skipped(current State, previous State) []string {
+	var states []string
+	lifecycle := lifecycles[current.Subscription]
+	previousRank := rank(previous)
+	logType := lifecycle[current.Type].predecessor
+	for logType != "" && logType != previous.Type {
+		stage := lifecycle[logType]
+		if stage.rank <= previousRank {
+			break
+		}
+		states = append([]string{logType}, states...)
+		logType = stage.predecessor
+	}
+	return states
+}
+
+func key(subscription string, entityId string) string {
+	return subscription + ":" + entityId
+}
+
+func describe(event Event.Event) (State, Error.StarkErrors) {
+	log, ok := event.ParsedLog()
+	if !ok {
+		return State{}, Error.UnknownError(fmt.Sprintf("event %s has no sequenceable log for subscription %s", event.Id, event.Subscription))
+	}
+	return State{
+		Subscription: event.Subscription,
+		EntityId:     log.EntityId(),
+		LogId:        log.LogId(),
+		Type:         log.LogType(),
+		Created:      log.LogCreated(),
+	}, Error.StarkErrors{}
+}
@@ -0,0 +1,94 @@
+package invoice
+
+import (
+	"encoding/json"
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"time"
+)
+
+//	Invoice.Discount struct
+//
+//	A Discount applied to the Invoice amount when it is paid until its Due date.
+//	Use dates instead of datetimes for scheduled Invoices.
+//
+//	Parameters (required):
+//	- Percentage [float64]: discount percentage over the nominal amount, above 0 and up to 100. ex: 2.5
+//	- Due [time.Time]: last date or datetime in which the discount is applied, before the Invoice due. ex: time.Date(2020, 3, 5, 0, 0, 0, 0, time.UTC)
+
+type Discount struct {
+	Percentage float64    `json:",omitempty"`
+	Due        *time.Time `json:",omitempty"`
+}
+
+//	Invoice.Description struct
+//
+//	A Description line shown to the payer of the Invoice.
+//
+//	Parameters (required):
+//	- Key [string]: description title. ex: "Product"
+//
+//	Parameters (optional):
+//	- Value [string, default nil]: description content. ex: "Iron Suit"
+
+type Description struct {
+	Key   string `json:",omitempty"`
+	Value string `json:",omitempty"`
+}
+
+const (
+	MaxDiscounts    = 5
+	MaxDescriptions = 15
+)
+
+func (d *Discount) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Percentage float64
+		Due        string
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	d.Percentage, d.Due = raw.Percentage, nil
+	if raw.Due == "" {
+		return nil
+	}
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02"} {
+		if due, err := time.Parse(layout, raw.Due); err == nil {
+			d.Due = &due
+			return nil
+		}
+	}
+	return fmt.Errorf("invoice discount due must be a date or datetime, but it is %q", raw.Due)
+}
+
+func checkTerms(invoice Invoice) []Error.StarkError {
+	var errors []Error.StarkError
+	add := func(code string, message string, args ...interface{}) {
+		errors = append(errors, Error.StarkError{Code: code, Message: fmt.Sprintf(message, args...)})
+	}
+
+	if len(invoice.Discounts) > MaxDiscounts {
+		add("invalidDiscount", "Invoice must have up to %d discounts, but it has %d", MaxDiscounts, len(invoice.Discounts))
+	}
+	for _, discount := range invoice.Discounts {
+		if discount.Percentage <= 0 || discount.Percentage > 100 {
+			add("invalidDiscount", "Invoice discount percentage must be above 0 and up to 100, but it is %v", discount.Percentage)
+		}
+		if discount.Due == nil {
+			add("invalidDiscount", "Invoice discount must have a due date")
+		} else if invoice.Due != nil && !discount.Due.Before(*invoice.Due) {
+			add("invalidDiscount", "Invoice discount due %s must be before the Invoice due %s", discount.Due.Format(time.RFC3339), invoice.Due.Format(time.RFC3339))
+		}
+	}
+
+	if len(invoice.Descriptions) > MaxDescriptions {
+		add("invalidDescription", "Invoice must have up to %d descriptions, but it has %d", MaxDescriptions, len(invoice.Descriptions))
+	}
+	for _, description := range invoice.Descriptions {
+		if description.Key == "" {
+			add("invalidDescription", "Invoice description must have a key")
+		}
+	}
+	return errors
+}
@@ -3,6 +3,8 @@ package invoice
 import (
 	"encoding/json"
 	"github.com/starkbank/sdk-go/starkbank/invoice/rule"
+	"github.com/starkbank/sdk-go/starkbank/qrcode"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -28,10 +30,10 @@ import (
 //	- Expiration [int, default 5097600 (59 days)]: time interval in seconds between due date and expiration date. ex: 123456789
 //	- Fine [float64, default 2.0]: Invoice fine for overdue payment in %. ex: 2.5
 //	- Interest [float64, default 1.0]: Invoice monthly interest for overdue payment in %. ex: 5.2
-//	- Discounts [slice of maps, default nil]: slice of maps with "percentage":float64 and "due":time.Time or string pairs
+//	- Discounts [slice of Invoice.Discount structs, default nil]: up to 5 discounts with their percentages and due dates. ex: []Invoice.Discount{{Percentage: 5, Due: &discountDue}}
 //	- Tags [slice of strings, default nil]: slice of strings for tagging. ex: []string{"John", "Paul"}
-//	- Rules [slice of Invoice.Rule structs, default nil]: slice of Invoice.Rule structs for modifying transfer behavior. ex: []rule.Rule{{Key: "allowedTaxIds", Value: []string{"012.345.678-90", "45.059.493/0001-73"}}},
-//	- Descriptions [slice of maps, default nil]: slice of maps with "key":string and (optional) "value":string pairs
+//	- Rules [slice of Invoice.Rule structs, default nil]: slice of Invoice.Rule structs for modifying transfer behavior. ex: []rule.Rule{rule.AllowedTaxIds("012.345.678-90", "45.059.493/0001-73")},
+//	- Descriptions [slice of Invoice.Description structs, default nil]: up to 15 descriptions shown to the payer. ex: []Invoice.Description{{Key: "Product", Value: "Iron Suit"}}
 //
 //	Attributes (return-only):
 //	- DisplayDescription [string, default nil]: optional description to be shown in the receiver bank interface. ex: "Payment for service 1234"
@@ -43,7 +45,7 @@ import (
 //	- DiscountAmount [int]: Invoice discount value calculated over nominalAmount. ex: 3000
 //	- Id [string]: unique id returned when Invoice is created. ex: "5656565656565656"
 //	- Brcode [string]: BR Code for the Invoice payment. ex: "00020101021226800014br.gov.bcb.pix2558invoice.starkbank.com/f5333103-3279-4db2-8389-5efe335ba93d5204000053039865802BR5913Arya Stark6009Sao Paulo6220051656565656565656566304A9A0"
-//	- Status [string]: current Invoice status. ex: "registered" or "paid"
+//	- Status [Status]: current Invoice status. ex: "created", "paid", "overdue", "canceled", "expired" or "voided"
 //	- Fee [int]: fee charged by this Invoice. ex: 200 (= R$ 2.00)
 //	- TransactionIds [slice of strings]: ledger transaction ids linked to this Invoice (if there are more than one, all but the first are reversals or failed reversal chargebacks). ex: []string{"19827356981273"}
 //	- Created [time.Time]: creation datetime for the Invoice. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
@@ -58,10 +60,10 @@ type Invoice struct {
 	Expiration     		int                      `json:",omitempty"`
 	Fine           		float64                  `json:",omitempty"`
 	Interest       		float64                  `json:",omitempty"`
-	Discounts      		[]map[string]interface{} `json:",omitempty"`
+	Discounts      		[]Discount               `json:",omitempty"`
 	Tags           		[]string                 `json:",omitempty"`
 	Rules          		[]rule.Rule              `json:",omitempty"`
-	Descriptions   		[]map[string]interface{} `json:",omitempty"`
+	Descriptions   		[]Description            `json:",omitempty"`
 	DisplayDescription  string                 	 `json:",omitempty"`
 	Pdf            		string                   `json:",omitempty"`
 	Link           		string                   `json:",omitempty"`
@@ -70,7 +72,7 @@ type Invoice struct {
 	InterestAmount 		int                      `json:",omitempty"`
 	DiscountAmount 		int                      `json:",omitempty"`
 	Brcode         		string                   `json:",omitempty"`
-	Status         		string                   `json:",omitempty"`
+	Status         		Status                   `json:",omitempty"`
 	Fee            		int                      `json:",omitempty"`
 	TransactionIds 		[]string                 `json:",omitempty"`
 	Created        		*time.Time               `json:",omitempty"`
@@ -92,6 +94,16 @@ func Create(invoices []Invoice, user user.User) ([]Invoice, Error.StarkErrors) {
 	//
 	//	Return:
 	//	- Slice of Invoice structs with updated attributes
+	var errors []Error.StarkError
+	for _, invoice := range invoices {
+		errors = append(errors, TaxId.Check(invoice.TaxId).Errors...)
+		errors = append(errors, rule.Check(invoice.Rules).Errors...)
+		errors = append(errors, checkTerms(invoice)...)
+	}
+	if errors != nil {
+		return invoices, Error.StarkErrors{Errors: errors}
+	}
+
 	create, err := utils.Multi(resource, invoices, nil, user)
 	unmarshalError := json.Unmarshal(create, &invoices)
 	if unmarshalError != nil {
@@ -130,9 +142,9 @@ func Query(params map[string]interface{}, user user.User) (chan Invoice, chan Er
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "paid" or "registered"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: invoice.Paid or []invoice.Status{invoice.Paid, invoice.Canceled}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -172,9 +184,9 @@ func Page(params map[string]interface{}, user user.User) ([]Invoice, string, Err
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: []string{"paid", "registered"}
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: invoice.Paid or []invoice.Status{invoice.Paid, invoice.Canceled}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -271,3 +283,25 @@ func GetPayment(id string, user user.User) (Payment, Error.StarkErrors) {
 	}
 	return payment, err
 }
+
+func (i Invoice) QrcodePng(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the Invoice QR Code png locally
+	//
+	//	The Invoice must have been created or retrieved first, since its Brcode is returned by the API.
+	//	The params are the ones described in qrcode.Png.
+	if i.Brcode == "" {
+		return nil, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidQrcode", Message: "Invoice has no BR Code to render"}}}
+	}
+	return qrcode.Png(i.Brcode, params)
+}
+
+func (i Invoice) QrcodeSvg(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the Invoice QR Code svg locally
+	//
+	//	The Invoice must have been created or retrieved first, since its Brcode is returned by the API.
+	//	The params are the ones described in qrcode.Svg.
+	if i.Brcode == "" {
+		return nil, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidQrcode", Message: "Invoice has no BR Code to render"}}}
+	}
+	return qrcode.Svg(i.Brcode, params)
+}
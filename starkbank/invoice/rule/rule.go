@@ -1,8 +1,16 @@
 package rule
 
+import (
+	"fmt"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
+	"github.com/starkbank/sdk-go/starkbank/utils"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+)
+
 //	Invoice.Rule struct
 //
 //	The Invoice.Rule struct modifies the behavior of Invoice structs when passed as an argument upon their creation.
+//	Use the constructors, such as AllowedTaxIds, to build rules that are checked before the Invoices are sent to the API.
 //
 //	Attributes (return-only):
 //	- Key [string]: Rule to be customized, describes what Invoice behavior will be altered. ex: "allowedTaxIds"
@@ -12,3 +20,52 @@ type Rule struct {
 	Key   string   `json:",omitempty"`
 	Value interface{} `json:",omitempty"`
 }
+
+const AllowedTaxIdsKey = "allowedTaxIds"
+
+func AllowedTaxIds(taxIds ...string) Rule {
+	//	Build a rule with the only tax IDs allowed to pay the Invoice. ex: rule.AllowedTaxIds("012.345.678-90", "45.059.493/0001-73")
+	return Rule{Key: AllowedTaxIdsKey, Value: taxIds}
+}
+
+func (r Rule) AllowedTaxIds() ([]string, bool) {
+	//	Get the tax IDs of an "allowedTaxIds" rule. The second return is false for other rules
+	taxIds, ok := toStrings(r.Value)
+	return taxIds, ok && r.Key == AllowedTaxIdsKey
+}
+
+func Check(rules []Rule) Error.StarkErrors {
+	//	Validate the value of each rule with a constructor, returning an "invalidRule" error for each invalid one.
+	//	Rules with other keys are sent to the API unchanged.
+	return utils.CheckRules(len(rules), func(index int) string {
+		rule := rules[index]
+		switch rule.Key {
+		case AllowedTaxIdsKey:
+			taxIds, ok := toStrings(rule.Value)
+			if !ok || len(taxIds) == 0 {
+				return fmt.Sprintf("Invoice rule %q must be a string or a non-empty slice of strings, but it is %v", rule.Key, rule.Value)
+			}
+			for _, taxId := range taxIds {
+				if err := TaxId.Validate(taxId); err.Errors != nil {
+					return fmt.Sprintf("Invoice rule %q has an invalid tax ID: %s", rule.Key, err.Errors[0].Message)
+				}
+			}
+		}
+		return ""
+	})
+}
+
+func (r *Rule) UnmarshalJSON(data []byte) (err error) {
+	r.Key, r.Value, err = utils.UnmarshalRule(data, map[string]interface{}{AllowedTaxIdsKey: []string{}})
+	return err
+}
+
+func toStrings(value interface{}) ([]string, bool) {
+	switch value := value.(type) {
+	case string:
+		return []string{value}, true
+	case []string:
+		return value, true
+	}
+	return nil, false
+}
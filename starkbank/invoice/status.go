@@ -0,0 +1,48 @@
+package invoice
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	Invoice Status type
+//
+//	Invoices are created and may be paid until they expire, even after becoming overdue. Unpaid
+//	Invoices may be canceled and paid Invoices are voided when they are fully reversed.
+
+type Status string
+
+const (
+	Created  Status = "created"
+	Paid     Status = "paid"
+	Overdue  Status = "overdue"
+	Canceled Status = "canceled"
+	Expired  Status = "expired"
+	Voided   Status = "voided"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"paid"},
+	Transitions: map[string][]string{
+		"created":  {"paid", "overdue", "canceled", "expired"},
+		"overdue":  {"paid", "canceled", "expired"},
+		"paid":     {"voided"},
+		"canceled": {},
+		"expired":  {},
+		"voided":   {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "paid"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
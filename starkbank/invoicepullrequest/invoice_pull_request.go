@@ -27,7 +27,7 @@ import (
 //
 //	Attributes (return-only):
 //	- Id [string]: unique id returned when InvoicePullRequest is created. ex: "5656565656565656"
-//	- Status [string]: current InvoicePullRequest status. ex: "pending", "scheduled", "success", "failed", "canceled"
+//	- Status [Status]: current InvoicePullRequest status. ex: "pending", "scheduled", "success", "failed" or "canceled"
 //	- InstallmentId [string]: unique id of the installment related to this request. ex: "5656565656565656"
 //	- Created [time.Time]: creation datetime for the InvoicePullRequest. ex: time.Date(2020, 3, 10, 30, 30, 0, 0, time.UTC)
 //	- Updated [time.Time]: latest update datetime for the InvoicePullRequest. ex: time.Date(2020, 3, 10, 30, 30, 0, 0, time.UTC)
@@ -41,7 +41,7 @@ type InvoicePullRequest struct {
 	Tags               []string   `json:",omitempty"`
 	ExternalId         string     `json:",omitempty"`
 	DisplayDescription string     `json:",omitempty"`
-	Status             string     `json:",omitempty"`
+	Status             Status     `json:",omitempty"`
 	InstallmentId      string     `json:",omitempty"`
 	Created            *time.Time `json:",omitempty"`
 	Updated            *time.Time `json:",omitempty"`
@@ -100,9 +100,9 @@ func Query(params map[string]interface{}, user user.User) (chan InvoicePullReque
 	//	Parameters (optional):
 	//	- params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: Maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "paid" or "registered"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: invoicepullrequest.Success or []invoicepullrequest.Status{invoicepullrequest.Success, invoicepullrequest.Failed}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -139,9 +139,9 @@ func Page(params map[string]interface{}, user user.User) ([]InvoicePullRequest,
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: []string{"paid", "registered"}
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: invoicepullrequest.Success or []invoicepullrequest.Status{invoicepullrequest.Success, invoicepullrequest.Failed}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
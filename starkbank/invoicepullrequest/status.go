@@ -0,0 +1,46 @@
+package invoicepullrequest
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	InvoicePullRequest Status type
+//
+//	InvoicePullRequests are pending until the payer's bank schedules them and then succeed or
+//	fail on their due date. They may be canceled before the payment is made.
+
+type Status string
+
+const (
+	Pending   Status = "pending"
+	Scheduled Status = "scheduled"
+	Success   Status = "success"
+	Failed    Status = "failed"
+	Canceled  Status = "canceled"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"success"},
+	Transitions: map[string][]string{
+		"pending":   {"scheduled", "failed", "canceled"},
+		"scheduled": {"success", "failed", "canceled"},
+		"success":   {},
+		"failed":    {},
+		"canceled":  {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "success"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
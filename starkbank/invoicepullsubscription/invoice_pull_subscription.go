@@ -2,6 +2,8 @@ package invoicepullsubscription
 
 import (
 	"encoding/json"
+	"github.com/starkbank/sdk-go/starkbank/qrcode"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -38,7 +40,7 @@ import (
 //
 //	Attributes (return-only):
 //	- Id [string]: unique id returned when InvoicePullSubscription is created. ex: "5656565656565656"
-//	- Status [string]: current InvoicePullSubscription status. ex: "active", "canceled"
+//	- Status [Status]: current InvoicePullSubscription status. ex: "created", "active", "canceled" or "expired"
 //	- BacenId [string]: unique authentication id at the Central Bank. ex: "RR2001818320250616dtsPkBVaBYs"
 //	- Brcode [string]: Brcode string for the InvoicePullSubscription. ex: "00020101021126580014br.gov.bcb.pix0114+5599999999990210starkbank.com.br520400005303986540410000000000005802BR5913Stark Bank S.A.6009SAO PAULO62070503***6304D2B1"
 //	- Created [time.Time]: creation datetime for the InvoicePullSubscription. time.Date(2020, 3, 10, 30, 30, 0, 0, time.UTC)
@@ -62,7 +64,7 @@ type InvoicePullSubscription struct {
 	Name               string                 `json:",omitempty"`
 	TaxId              string                 `json:",omitempty"`
 	Tags               []string               `json:",omitempty"`
-	Status             string                 `json:",omitempty"`
+	Status             Status                 `json:",omitempty"`
 	BacenId            string                 `json:",omitempty"`
 	Brcode             string                 `json:",omitempty"`
 	Created            *time.Time             `json:",omitempty"`
@@ -84,6 +86,14 @@ func Create(subscriptions []InvoicePullSubscription, user user.User) ([]InvoiceP
 	//
 	//	Return:
 	//	- Slice of InvoicePullSubscription structs with updated attributes
+	var errors []Error.StarkError
+	for _, subscription := range subscriptions {
+		errors = append(errors, TaxId.Check(subscription.TaxId).Errors...)
+	}
+	if errors != nil {
+		return subscriptions, Error.StarkErrors{Errors: errors}
+	}
+
 	create, err := utils.Multi(resource, subscriptions, nil, user)
 	if err.Errors != nil {
 		return subscriptions, err
@@ -133,9 +143,9 @@ func Query(params map[string]interface{}, user user.User) (chan InvoicePullSubsc
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: "paid" or "registered"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: invoicepullsubscription.Active or []invoicepullsubscription.Status{invoicepullsubscription.Active, invoicepullsubscription.Canceled}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -177,9 +187,9 @@ func Page(params map[string]interface{}, user user.User) ([]InvoicePullSubscript
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: Cursor returned on the previous page function call
 	//		- limit [int, default 100]: Maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
-	//		- status [string, default nil]: Filter for status of retrieved structs. ex: []string{"paid", "registered"}
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: invoicepullsubscription.Active or []invoicepullsubscription.Status{invoicepullsubscription.Active, invoicepullsubscription.Canceled}
 	//		- tags [slice of strings, default nil]: Tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -221,3 +231,25 @@ func Cancel(id string, user user.User) (InvoicePullSubscription, Error.StarkErro
 	}
 	return invoicePullSubscription, err
 }
+
+func (s InvoicePullSubscription) QrcodePng(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the InvoicePullSubscription QR Code png locally
+	//
+	//	The InvoicePullSubscription must have been created or retrieved first, since its Brcode is returned by the API.
+	//	The params are the ones described in qrcode.Png.
+	if s.Brcode == "" {
+		return nil, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidQrcode", Message: "InvoicePullSubscription has no BR Code to render"}}}
+	}
+	return qrcode.Png(s.Brcode, params)
+}
+
+func (s InvoicePullSubscription) QrcodeSvg(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the InvoicePullSubscription QR Code svg locally
+	//
+	//	The InvoicePullSubscription must have been created or retrieved first, since its Brcode is returned by the API.
+	//	The params are the ones described in qrcode.Svg.
+	if s.Brcode == "" {
+		return nil, Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidQrcode", Message: "InvoicePullSubscription has no BR Code to render"}}}
+	}
+	return qrcode.Svg(s.Brcode, params)
+}
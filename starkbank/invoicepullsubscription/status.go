@@ -0,0 +1,44 @@
+package invoicepullsubscription
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	InvoicePullSubscription Status type
+//
+//	InvoicePullSubscriptions are created and become active once the payer authorizes them. They
+//	may be canceled by either side or expire without authorization.
+
+type Status string
+
+const (
+	Created  Status = "created"
+	Active   Status = "active"
+	Canceled Status = "canceled"
+	Expired  Status = "expired"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"active"},
+	Transitions: map[string][]string{
+		"created":  {"active", "canceled", "expired"},
+		"active":   {"canceled"},
+		"canceled": {},
+		"expired":  {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "active"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
@@ -63,3 +63,29 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	}
 	return installmentLogs, cursor, err
 }
+
+var _ utils.Log = Log{}
+
+func (l Log) LogId() string {
+	return l.Id
+}
+
+func (l Log) LogType() string {
+	return l.Type
+}
+
+func (l Log) LogCreated() *time.Time {
+	return l.Created
+}
+
+func (l Log) EntityId() string {
+	return l.Installment.Id
+}
+
+func (l Log) EntityStatus() string {
+	return l.Installment.Status
+}
+
+func (l Log) LogErrors() []string {
+	return utils.ErrorMessages(l.Errors)
+}
@@ -40,7 +40,7 @@ type MerchantPurchase struct {
 	Fee                int                    `json:",omitempty"`
 	Network            string                 `json:",omitempty"`
 	Source             string                 `json:",omitempty"`
-	Status             string                 `json:",omitempty"`
+	Status             Status                 `json:",omitempty"`
 	Tags               []string               `json:",omitempty"`
 	Updated            *time.Time             `json:",omitempty"`
 }
@@ -0,0 +1,50 @@
+package merchantpurchase
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	MerchantPurchase Status type
+//
+//	MerchantPurchases are created and then approved or denied by the card issuer. Approved
+//	purchases are confirmed when captured and may be canceled or voided afterwards.
+
+type Status string
+
+const (
+	Created   Status = "created"
+	Approved  Status = "approved"
+	Denied    Status = "denied"
+	Confirmed Status = "confirmed"
+	Canceled  Status = "canceled"
+	Voided    Status = "voided"
+	Failed    Status = "failed"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"approved", "confirmed"},
+	Transitions: map[string][]string{
+		"created":   {"approved", "denied", "failed"},
+		"approved":  {"confirmed", "canceled", "voided"},
+		"confirmed": {"voided"},
+		"denied":    {},
+		"canceled":  {},
+		"voided":    {},
+		"failed":    {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "approved" or "confirmed"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
@@ -62,3 +62,29 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	}
 	return logs, cursor, err
 }
+
+var _ utils.Log = Log{}
+
+func (l Log) LogId() string {
+	return l.Id
+}
+
+func (l Log) LogType() string {
+	return l.Type
+}
+
+func (l Log) LogCreated() *time.Time {
+	return l.Created
+}
+
+func (l Log) EntityId() string {
+	return l.Session.Id
+}
+
+func (l Log) EntityStatus() string {
+	return l.Session.Status
+}
+
+func (l Log) LogErrors() []string {
+	return utils.ErrorMessages(l.Errors)
+}
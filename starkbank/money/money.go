@@ -0,0 +1,406 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"math"
+	"strconv"
+	"strings"
+)
+
+//	Money struct
+//
+//	An amount in cents of a currency, such as the Amount, Fee and NominalAmount fields of the
+//	Stark Bank structs. Money is encoded to and decoded from JSON as an integer number of cents,
+//	like those fields, so it can be used in your own structs that mirror the API payloads.
+//	The zero value is R$ 0,00.
+//
+//	Parameters (required):
+//	- Cents [int64]: amount in cents. ex: 123456 (= R$ 1.234,56)
+//
+//	Parameters (optional):
+//	- Currency [string, default "BRL"]: ISO 4217 currency code. ex: "USD"
+
+type Money struct {
+	Cents    int64
+	Currency string
+}
+
+const Brl = "BRL"
+
+var symbols = map[string]map[string]string{
+	"pt-BR": {"BRL": "R$ ", "USD": "US$ ", "EUR": "€ "},
+	"en":    {"BRL": "R$", "USD": "$", "EUR": "€"},
+}
+
+func New(cents int) Money {
+	//	Create a BRL Money from an amount in cents, such as the Amount of an Invoice. ex: money.New(invoice.Amount)
+	return Money{Cents: int64(cents), Currency: Brl}
+}
+
+func Parse(value string) (Money, Error.StarkErrors) {
+	//	Parse a formatted amount
+	//
+	//	Both pt-BR and en formats are accepted, with an optional currency symbol or code. When the
+	//	amount has a single kind of separator, it is the decimal separator if it appears once and is
+	//	followed by up to 2 digits, and a thousands separator otherwise. Amounts with more than
+	//	2 decimal places are rejected instead of rounded.
+	//
+	//	Parameters (required):
+	//	- value [string]: formatted amount. ex: "R$ 1.234,56", "-1,234.56", "1234.5", "USD 10" or "12,00"
+	//
+	//	Return:
+	//	- Money struct. ex: money.Money{Cents: 123456, Currency: "BRL"}
+	number := strings.TrimSpace(value)
+	negative := strings.HasPrefix(number, "-")
+	if negative {
+		number = strings.TrimSpace(number[1:])
+	}
+	number, currency := stripCurrency(number)
+	if strings.HasPrefix(number, "-") && !negative {
+		negative = true
+		number = strings.TrimSpace(number[1:])
+	}
+
+	integer, fraction, ok := splitDecimal(number)
+	if !ok {
+		return Money{}, invalid("Money amount %q is not a valid number with up to 2 decimal places", value)
+	}
+	units, err := strconv.ParseInt(integer, 10, 64)
+	if err != nil {
+		return Money{}, invalid("Money amount %q is too large", value)
+	}
+	cents, _ := strconv.ParseInt((fraction + "00")[:2], 10, 64)
+	total, overflow := multiply(units, 100)
+	if !overflow {
+		total, overflow = add(total, cents)
+	}
+	if overflow {
+		return Money{}, Error.StarkErrors{Errors: []Error.StarkError{{Code: "moneyOverflow", Message: fmt.Sprintf("Money amount %q is too large", value)}}}
+	}
+	if negative {
+		total = -total
+	}
+	return Money{Cents: total, Currency: currency}, Error.StarkErrors{}
+}
+
+func (m Money) Int() int {
+	//	Get the amount in cents to be assigned to the int fields of the Stark Bank structs. ex: invoice.Amount = price.Int()
+	return int(m.Cents)
+}
+
+func (m Money) Code() string {
+	//	Get the currency code, which is "BRL" if the Currency is empty
+	if m.Currency == "" {
+		return Brl
+	}
+	return strings.ToUpper(m.Currency)
+}
+
+func (m Money) IsZero() bool {
+	//	Return true if the amount is zero
+	return m.Cents == 0
+}
+
+func (m Money) IsNegative() bool {
+	//	Return true if the amount is below zero
+	return m.Cents < 0
+}
+
+func (m Money) Add(other Money) (Money, Error.StarkErrors) {
+	//	Add two amounts of the same currency, returning a "currencyMismatch" or "moneyOverflow" error if they cannot be added
+	if err := m.sameCurrency(other); err.Errors != nil {
+		return Money{}, err
+	}
+	total, overflow := add(m.Cents, other.Cents)
+	if overflow {
+		return Money{}, overflowError(m, "+", other)
+	}
+	return Money{Cents: total, Currency: m.Code()}, Error.StarkErrors{}
+}
+
+func (m Money) Sub(other Money) (Money, Error.StarkErrors) {
+	//	Subtract an amount of the same currency, returning a "currencyMismatch" or "moneyOverflow" error if they cannot be subtracted
+	if err := m.sameCurrency(other); err.Errors != nil {
+		return Money{}, err
+	}
+	if other.Cents == math.MinInt64 {
+		return Money{}, overflowError(m, "-", other)
+	}
+	total, overflow := add(m.Cents, -other.Cents)
+	if overflow {
+		return Money{}, overflowError(m, "-", other)
+	}
+	return Money{Cents: total, Currency: m.Code()}, Error.StarkErrors{}
+}
+
+func (m Money) Multiply(factor int64) (Money, Error.StarkErrors) {
+	//	Multiply the amount by an integer factor, returning a "moneyOverflow" error if the result does not fit
+	total, overflow := multiply(m.Cents, factor)
+	if overflow {
+		return Money{}, overflowError(m, "*", Money{Cents: factor, Currency: m.Code()})
+	}
+	return Money{Cents: total, Currency: m.Code()}, Error.StarkErrors{}
+}
+
+func (m Money) Allocate(ratios ...int) ([]Money, Error.StarkErrors) {
+	//	Split the amount proportionally to the ratios without losing cents
+	//
+	//	The cents left by the integer division are given one by one to the first parts, so the
+	//	parts always add up to the original amount.
+	//
+	//	Parameters (required):
+	//	- ratios [ints]: relative size of each part. ex: 70, 20, 10
+	//
+	//	Return:
+	//	- slice of Money structs, one for each ratio. ex: R$ 0,10 allocated by 1, 1, 1 returns R$ 0,04, R$ 0,03 and R$ 0,03
+	total := int64(0)
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, invalid("Money allocation ratios must not be negative, but they are %v", ratios)
+		}
+		total += int64(ratio)
+	}
+	if total == 0 {
+		return nil, invalid("Money allocation ratios must add up to more than 0, but they are %v", ratios)
+	}
+
+	if m.Cents == math.MinInt64 {
+		return nil, overflowError(m, "/", Money{Cents: total, Currency: m.Code()})
+	}
+	cents := m.Cents
+	sign := int64(1)
+	if cents < 0 {
+		cents, sign = -cents, -1
+	}
+	parts := make([]Money, len(ratios))
+	remainder := cents
+	for i, ratio := range ratios {
+		share := cents / total * int64(ratio)
+		extra, overflow := multiply(cents%total, int64(ratio))
+		if overflow {
+			return nil, overflowError(m, "/", Money{Cents: total, Currency: m.Code()})
+		}
+		share += extra / total
+		parts[i] = Money{Cents: share, Currency: m.Code()}
+		remainder -= share
+	}
+	for i := 0; remainder > 0; i = (i + 1) % len(parts) {
+		if ratios[i] > 0 {
+			parts[i].Cents++
+			remainder--
+		}
+	}
+	for i := range parts {
+		parts[i].Cents *= sign
+	}
+	return parts, Error.StarkErrors{}
+}
+
+func (m Money) Split(parts int) ([]Money, Error.StarkErrors) {
+	//	Split the amount in equal parts without losing cents. See Allocate
+	if parts <= 0 {
+		return nil, invalid("Money must be split in at least 1 part, but it is %d", parts)
+	}
+	ratios := make([]int, parts)
+	for i := range ratios {
+		ratios[i] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+func (m Money) Decimal() string {
+	//	Get the amount as a decimal string without currency. ex: "-1234.56"
+	sign := ""
+	if m.Cents < 0 {
+		sign = "-"
+	}
+	units, cents := absolute(m.Cents)
+	return fmt.Sprintf("%s%d.%02d", sign, units, cents)
+}
+
+func (m Money) Format(locale string) string {
+	//	Format the amount with its currency symbol
+	//
+	//	Parameters (required):
+	//	- locale [string]: "pt-BR" or "en". ex: "en"
+	//
+	//	Return:
+	//	- formatted amount. ex: "R$ 1.234,56" for "pt-BR" and "R$1,234.56" for "en"
+	thousands, decimal := ".", ","
+	if locale != "pt-BR" {
+		locale, thousands, decimal = "en", ",", "."
+	}
+	symbol, ok := symbols[locale][m.Code()]
+	if !ok {
+		symbol = m.Code() + " "
+	}
+
+	units, cents := absolute(m.Cents)
+	digits := strconv.FormatUint(units, 10)
+	var grouped []string
+	for len(digits) > 3 {
+		grouped = append([]string{digits[len(digits)-3:]}, grouped...)
+		digits = digits[:len(digits)-3]
+	}
+	grouped = append([]string{digits}, grouped...)
+
+	sign := ""
+	if m.Cents < 0 {
+		sign = "-"
+	}
+	return fmt.Sprintf("%s%s%s%s%02d", sign, symbol, strings.Join(grouped, thousands), decimal, cents)
+}
+
+func (m Money) String() string {
+	//	Format the amount in pt-BR. ex: "R$ 1.234,56"
+	return m.Format("pt-BR")
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Cents)
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var cents int64
+	if err := json.Unmarshal(data, &cents); err != nil {
+		return fmt.Errorf("money must be an integer number of cents: %s", data)
+	}
+	m.Cents = cents
+	if m.Currency == "" {
+		m.Currency = Brl
+	}
+	return nil
+}
+
+func (m Money) sameCurrency(other Money) Error.StarkErrors {
+	if m.Code() != other.Code() {
+		return Error.StarkErrors{Errors: []Error.StarkError{{
+			Code:    "currencyMismatch",
+			Message: fmt.Sprintf("Money currencies must be the same, but they are %s and %s", m.Code(), other.Code()),
+		}}}
+	}
+	return Error.StarkErrors{}
+}
+
+func stripCurrency(number string) (string, string) {
+	for _, prefix := range []struct{ symbol, currency string }{{"R$", "BRL"}, {"US$", "USD"}, {"$", "USD"}, {"€", "EUR"}} {
+		if strings.HasPrefix(number, prefix.symbol) {
+			return strings.TrimSpace(number[len(prefix.symbol):]), prefix.currency
+		}
+	}
+	if len(number) > 3 && isLetters(number[:3]) {
+		return strings.TrimSpace(number[3:]), strings.ToUpper(number[:3])
+	}
+	if len(number) > 3 && isLetters(number[len(number)-3:]) {
+		return strings.TrimSpace(number[:len(number)-3]), strings.ToUpper(number[len(number)-3:])
+	}
+	return number, Brl
+}
+
+func splitDecimal(number string) (string, string, bool) {
+	decimal := ""
+	lastDot, lastComma := strings.LastIndex(number, "."), strings.LastIndex(number, ",")
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		decimal = number[max(lastDot, lastComma) : max(lastDot, lastComma)+1]
+	case lastDot >= 0 || lastComma >= 0:
+		separator := number[max(lastDot, lastComma) : max(lastDot, lastComma)+1]
+		if strings.Count(number, separator) == 1 && len(number)-max(lastDot, lastComma)-1 <= 2 {
+			decimal = separator
+		}
+	}
+
+	integer, fraction := number, ""
+	if decimal != "" {
+		index := strings.LastIndex(number, decimal)
+		integer, fraction = number[:index], number[index+1:]
+		if fraction == "" || len(fraction) > 2 || !isDigits(fraction) {
+			return "", "", false
+		}
+	}
+	if index := strings.IndexAny(integer, ".,"); index >= 0 {
+		thousands := integer[index : index+1]
+		if thousands == decimal {
+			return "", "", false
+		}
+		groups := strings.Split(integer, thousands)
+		if len(groups[0]) == 0 || len(groups[0]) > 3 {
+			return "", "", false
+		}
+		for _, group := range groups[1:] {
+			if len(group) != 3 {
+				return "", "", false
+			}
+		}
+		integer = strings.Join(groups, "")
+	}
+	if integer == "" && fraction != "" {
+		integer = "0"
+	}
+	if !isDigits(integer) {
+		return "", "", false
+	}
+	return integer, fraction, true
+}
+
+func add(a int64, b int64) (int64, bool) {
+	result := a + b
+	return result, (b > 0 && result < a) || (b < 0 && result > a)
+}
+
+func multiply(a int64, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, false
+	}
+	result := a * b
+	return result, result/b != a || (a == -1 && b == math.MinInt64) || (b == -1 && a == math.MinInt64)
+}
+
+func absolute(cents int64) (uint64, int64) {
+	value := uint64(cents)
+	if cents < 0 {
+		value = uint64(-(cents + 1)) + 1
+	}
+	return value / 100, int64(value % 100)
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, char := range value {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isLetters(value string) bool {
+	for _, char := range value {
+		if !(char >= 'a' && char <= 'z') && !(char >= 'A' && char <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+func max(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func invalid(message string, args ...interface{}) Error.StarkErrors {
+	return Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidMoney", Message: fmt.Sprintf(message, args...)}}}
+}
+
+func overflowError(m Money, operation string, other Money) Error.StarkErrors {
+	return Error.StarkErrors{Errors: []Error.StarkError{{
+		Code:    "moneyOverflow",
+		Message: fmt.Sprintf("Money operation %s %s %s does not fit in 64 bits", m.Decimal(), operation, other.Decimal()),
+	}}}
+}
@@ -1,6 +1,9 @@
 package paymentpreview
 
-import "time"
+import (
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	"time"
+)
 
 //	BoletoPreview struct
 //
@@ -12,7 +15,7 @@ import "time"
 //	- DiscountAmount [int]: Discount amount to be paid. ex: 23456 (= R$ 234.56)
 //	- FineAmount [int]: Fine amount to be paid. ex: 23456 (= R$ 234.56)
 //	- InterestAmount [int]: Interest amount to be paid. ex: 23456 (= R$ 234.56)
-//	- Due [time.Time]: Boleto due date. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
+//	- Due [date.Date]: Boleto due date. ex: date.New(2020, time.March, 10),
 //	- Expiration [time.Time]: Boleto expiration date. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
 //	- Name [string]: Beneficiary full name. ex: "Anthony Edward Stark"
 //	- TaxId [string]: Beneficiary tax ID (CPF or CNPJ). ex: "20.018.183/0001-80"
@@ -29,7 +32,7 @@ type BoletoPreview struct {
 	DiscountAmount int        `json:",omitempty"`
 	FineAmount     int        `json:",omitempty"`
 	InterestAmount int        `json:",omitempty"`
-	Due            *time.Time `json:",omitempty"`
+	Due            *Date.Date `json:",omitempty"`
 	Expiration     *time.Time `json:",omitempty"`
 	Name           string     `json:",omitempty"`
 	TaxId          string     `json:",omitempty"`
@@ -40,5 +43,3 @@ type BoletoPreview struct {
 	Line           string     `json:",omitempty"`
 	BarCode        string     `json:",omitempty"`
 }
-
-var PreviewBoleto BoletoPreview
@@ -34,5 +34,3 @@ type BrcodePreview struct {
 	DiscountAmount   int    `json:",omitempty"`
 	ReconciliationId string `json:",omitempty"`
 }
-
-var PreviewBrcode BrcodePreview
@@ -2,10 +2,10 @@ package paymentpreview
 
 import (
 	"encoding/json"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
-	"time"
 )
 
 //	PaymentPreview struct
@@ -17,17 +17,17 @@ import (
 //	- Id [string]: Main identification of the payment. This should be the BR Code for Pix payments and lines or bar codes for payment slips. ex: "34191.09008 63571.277308 71444.640008 5 81960000000062", "00020126580014br.gov.bcb.pix0136a629532e-7693-4846-852d-1bbff817b5a8520400005303986540510.005802BR5908T'Challa6009Sao Paulo62090505123456304B14A"
 //
 //	Parameters (optional):
-//	- Scheduled [time.time, default today]: intended payment date. Right now, this parameter only has effect on BrcodePreviews. ex: time.Date(2020, 3, 10, 0, 0, 10, 0, time.UTC),
+//	- Scheduled [date.Date, default today]: intended payment date. Right now, this parameter only has effect on BrcodePreviews. ex: date.New(2020, time.March, 10),
 //
 //	Attributes (return-only):
 //	- Type [string]: Payment type. ex: "brcode-payment", "boleto-payment", "utility-payment" or "tax-payment"
-//	- Payment [BrcodePreview struct, BoletoPreview struct, UtilityPreview or TaxPreview struct]: Information preview of the informed payment.
+//	- Payment [BrcodePreview struct, BoletoPreview struct, UtilityPreview or TaxPreview struct]: Information preview of the informed payment. Use AsBrcode, AsBoleto, AsUtility or AsTax to get it typed
 
 type PaymentPreview struct {
 	Id        string      `json:",omitempty"`
 	Payment   interface{} `json:",omitempty"`
 	Type      string      `json:",omitempty"`
-	Scheduled interface{} `json:",omitempty"`
+	Scheduled *Date.Date  `json:",omitempty"`
 }
 
 var subresource = map[string]string{"name": "PaymentPreview"}
@@ -50,12 +50,13 @@ func Create(previews []PaymentPreview, user user.User) ([]PaymentPreview, Error.
 		return nil, err
 	}
 
-	unmarshalError := json.Unmarshal(create, &previews)
+	var created []PaymentPreview
+	unmarshalError := json.Unmarshal(create, &created)
 	if unmarshalError != nil {
 		return nil, Error.UnknownError(unmarshalError.Error())
 	}
 
-	parsedPreviews, parseErr := ParsePreviews(previews)
+	parsedPreviews, parseErr := ParsePreviews(created)
 	if parseErr.Errors != nil {
 		return nil, parseErr
 	}
@@ -64,59 +65,80 @@ func Create(previews []PaymentPreview, user user.User) ([]PaymentPreview, Error.
 
 func (e PaymentPreview) ParsePreview() (PaymentPreview, Error.StarkErrors) {
 	if e.Type == "tax-payment" {
+		var tax TaxPreview
 		marshal, _ := json.Marshal(e.Payment)
-		unmarshalError := json.Unmarshal(marshal, &PreviewTax)
+		unmarshalError := json.Unmarshal(marshal, &tax)
 		if unmarshalError != nil {
 			return PaymentPreview{}, Error.UnknownError(unmarshalError.Error())
 		}
-		scheduled, _ := time.Parse("2006-01-02", e.Scheduled.(string))
-		e.Scheduled = scheduled
-		e.Payment = PreviewTax
+		e.Payment = tax
 		return e, Error.StarkErrors{}
 	}
 	if e.Type == "brcode-payment" {
+		var brcode BrcodePreview
 		marshal, _ := json.Marshal(e.Payment)
-		unmarshalError := json.Unmarshal(marshal, &PreviewBrcode)
+		unmarshalError := json.Unmarshal(marshal, &brcode)
 		if unmarshalError != nil {
 			return PaymentPreview{}, Error.UnknownError(unmarshalError.Error())
 		}
-		scheduled, _ := time.Parse("2006-01-02", e.Scheduled.(string))
-		e.Scheduled = scheduled
-		e.Payment = PreviewBrcode
+		e.Payment = brcode
 		return e, Error.StarkErrors{}
 	}
 	if e.Type == "boleto-payment" {
+		var boleto BoletoPreview
 		marshal, _ := json.Marshal(e.Payment)
-		unmarshalError := json.Unmarshal(marshal, &PreviewBoleto)
+		unmarshalError := json.Unmarshal(marshal, &boleto)
 		if unmarshalError != nil {
 			return PaymentPreview{}, Error.UnknownError(unmarshalError.Error())
 		}
-		scheduled, _ := time.Parse("2006-01-02", e.Scheduled.(string))
-		e.Scheduled = scheduled
-		e.Payment = PreviewBoleto
+		e.Payment = boleto
 		return e, Error.StarkErrors{}
 	}
 	if e.Type == "utility-payment" {
+		var utility UtilityPreview
 		marshal, _ := json.Marshal(e.Payment)
-		unmarshalError := json.Unmarshal(marshal, &PreviewUtility)
+		unmarshalError := json.Unmarshal(marshal, &utility)
 		if unmarshalError != nil {
 			return PaymentPreview{}, Error.UnknownError(unmarshalError.Error())
 		}
-		scheduled, _ := time.Parse("2006-01-02", e.Scheduled.(string))
-		e.Scheduled = scheduled
-		e.Payment = PreviewUtility
+		e.Payment = utility
 		return e, Error.StarkErrors{}
 	}
 	return e, Error.StarkErrors{}
 }
 
 func ParsePreviews(previews []PaymentPreview) ([]PaymentPreview, Error.StarkErrors) {
-	var err Error.StarkErrors
-	for i := 0; i < len(previews); i++ {
-		previews[i], err = previews[i].ParsePreview()
+	parsed := make([]PaymentPreview, len(previews))
+	for i, preview := range previews {
+		var err Error.StarkErrors
+		parsed[i], err = preview.ParsePreview()
 		if err.Errors != nil {
 			return nil, err
 		}
 	}
-	return previews, Error.StarkErrors{}
+	return parsed, Error.StarkErrors{}
+}
+
+func (e PaymentPreview) AsBoleto() (BoletoPreview, bool) {
+	//	Get the BoletoPreview of a "boleto-payment" preview. The second return is false for other types
+	preview, ok := e.Payment.(BoletoPreview)
+	return preview, ok
+}
+
+func (e PaymentPreview) AsBrcode() (BrcodePreview, bool) {
+	//	Get the BrcodePreview of a "brcode-payment" preview. The second return is false for other types
+	preview, ok := e.Payment.(BrcodePreview)
+	return preview, ok
+}
+
+func (e PaymentPreview) AsTax() (TaxPreview, bool) {
+	//	Get the TaxPreview of a "tax-payment" preview. The second return is false for other types
+	preview, ok := e.Payment.(TaxPreview)
+	return preview, ok
+}
+
+func (e PaymentPreview) AsUtility() (UtilityPreview, bool) {
+	//	Get the UtilityPreview of a "utility-payment" preview. The second return is false for other types
+	preview, ok := e.Payment.(UtilityPreview)
+	return preview, ok
 }
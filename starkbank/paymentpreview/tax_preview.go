@@ -18,5 +18,3 @@ type TaxPreview struct {
 	Line        string `json:",omitempty"`
 	BarCode     string `json:",omitempty"`
 }
-
-var PreviewTax TaxPreview
@@ -18,5 +18,3 @@ type UtilityPreview struct {
 	Line        string `json:",omitempty"`
 	BarCode     string `json:",omitempty"`
 }
-
-var PreviewUtility UtilityPreview
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	BoletoPayment "github.com/starkbank/sdk-go/starkbank/boletopayment"
 	BrcodePayment "github.com/starkbank/sdk-go/starkbank/brcodepayment"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
 	TaxPayment "github.com/starkbank/sdk-go/starkbank/taxpayment"
 	Transaction "github.com/starkbank/sdk-go/starkbank/transaction"
 	Transfer "github.com/starkbank/sdk-go/starkbank/transfer"
@@ -30,7 +31,7 @@ import (
 //	- Type [string]: payment type, inferred from the payment parameter if it is not a dictionary. ex: "transfer", "boleto-payment"
 //
 //	Parameters (optional):
-//	- Due [time.Time, default today]: Payment target date in ISO format. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
+//	- Due [date.Date, default today]: Payment target date in ISO format. ex: date.New(2020, time.March, 10),
 //	- Tags [slice of strings, default nil]: slice of strings for tagging. ex: []string{"John", "Paul"}
 //
 //	Attributes (return-only):
@@ -46,7 +47,7 @@ type PaymentRequest struct {
 	CenterId    string                   `json:",omitempty"`
 	Payment     interface{}              `json:",omitempty"`
 	Type        string                   `json:",omitempty"`
-	Due         *time.Time               `json:",omitempty"`
+	Due         *Date.Date               `json:",omitempty"`
 	Tags        []string                 `json:",omitempty"`
 	Amount      int                      `json:",omitempty"`
 	Description string                   `json:",omitempty"`
@@ -99,8 +100,8 @@ func Query(centerId string, params map[string]interface{}, user user.User) (chan
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: date filter for structs created or updated only after specified date.
-	//		- before [string, default nil]: date filter for structs created or updated only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for structs created or updated only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for structs created or updated only before specified date.
 	//		- sort [string, default "-created"]: sort order considered in response. Valid options are "-created" or "-due".
 	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success" or "failed"
 	//		- type [string, default nil]: payment type, inferred from the payment parameter if it is not a dictionary. ex: "transfer", "boleto-payment"
@@ -155,8 +156,8 @@ func Page(centerId string, params map[string]interface{}, user user.User) ([]Pay
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: cursor returned on the previous page function call
 	//		- limit [int, default 100]: maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: date filter for structs created or updated only after specified date.
-	//		- before [string, default nil]: date filter for structs created or updated only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for structs created or updated only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for structs created or updated only before specified date.
 	//		- sort [string, default "-created"]: sort order considered in response. Valid options are "-created" or "-due".
 	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success" or "failed"
 	//		- type [string, default nil]: payment type, inferred from the payment parameter if it is not a dictionary. ex: "transfer", "boleto-payment"
@@ -0,0 +1,209 @@
+package pixkey
+
+import (
+	"fmt"
+	DictKey "github.com/starkbank/sdk-go/starkbank/dictkey"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
+	Transfer "github.com/starkbank/sdk-go/starkbank/transfer"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"regexp"
+	"strings"
+)
+
+//	PixKey struct
+//
+//	A Pix key detected and normalized from user input, in the format used by the DICT.
+//
+//	Attributes:
+//	- Type [string]: key type, as in DictKey.Type: "cpf", "cnpj", "phone", "email" or "evp". ex: "phone"
+//	- Value [string]: normalized key. ex: "+5511988887777", "tony@starkbank.com", "01234567890" or "b6295ee1-f054-47d1-9e90-ee57b74f60d9"
+
+type PixKey struct {
+	Type  string
+	Value string
+}
+
+const (
+	Cpf   = "cpf"
+	Cnpj  = "cnpj"
+	Phone = "phone"
+	Email = "email"
+	Evp   = "evp"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`^[a-z0-9.!#$&'*+/=?^_{|}~-]+@[a-z0-9-]+(\.[a-z0-9-]+)+$`)
+	evpPattern   = regexp.MustCompile(`^[0-9a-f]{8}-?[0-9a-f]{4}-?[0-9a-f]{4}-?[0-9a-f]{4}-?[0-9a-f]{12}$`)
+	taxIdPattern = regexp.MustCompile(`^(\d{3}\.\d{3}\.\d{3}-\d{2}|[0-9A-Za-z]{2}\.[0-9A-Za-z]{3}\.[0-9A-Za-z]{3}/[0-9A-Za-z]{4}-\d{2})$`)
+	phoneMarks   = strings.NewReplacer(" ", "", "(", "", ")", "", "-", "", ".", "")
+)
+
+var areaCodes = map[string]bool{
+	"11": true, "12": true, "13": true, "14": true, "15": true, "16": true, "17": true, "18": true, "19": true,
+	"21": true, "22": true, "24": true, "27": true, "28": true,
+	"31": true, "32": true, "33": true, "34": true, "35": true, "37": true, "38": true,
+	"41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true, "49": true,
+	"51": true, "53": true, "54": true, "55": true,
+	"61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "69": true,
+	"71": true, "73": true, "74": true, "75": true, "77": true, "79": true,
+	"81": true, "82": true, "83": true, "84": true, "85": true, "86": true, "87": true, "88": true, "89": true,
+	"91": true, "92": true, "93": true, "94": true, "95": true, "96": true, "97": true, "98": true, "99": true,
+}
+
+func Parse(key string) (PixKey, Error.StarkErrors) {
+	//	Detect, normalize and validate a Pix key
+	//
+	//	Emails are lowercased, EVPs are lowercased with hyphens, CPFs and CNPJs keep only their
+	//	digits and phones are converted to the E.164 format with the Brazilian country code.
+	//	Eleven digits without formatting are taken as a CPF when they have valid verifier digits
+	//	and as a mobile phone with area code otherwise.
+	//
+	//	Parameters (required):
+	//	- key [string]: Pix key as typed by the user. ex: "(11) 98888-7777", "Tony@StarkBank.com" or "722.461.430-04"
+	//
+	//	Return:
+	//	- PixKey struct
+	trimmed := strings.TrimSpace(key)
+	lower := strings.ToLower(trimmed)
+
+	switch {
+	case strings.Contains(trimmed, "@"):
+		if len(lower) > 77 || !emailPattern.MatchString(lower) {
+			return PixKey{}, invalid("Pix key %q is not a valid email", key)
+		}
+		return PixKey{Type: Email, Value: lower}, Error.StarkErrors{}
+	case evpPattern.MatchString(lower):
+		hex := strings.Replace(lower, "-", "", -1)
+		return PixKey{Type: Evp, Value: fmt.Sprintf("%s-%s-%s-%s-%s", hex[0:8], hex[8:12], hex[12:16], hex[16:20], hex[20:32])}, Error.StarkErrors{}
+	case taxIdPattern.MatchString(trimmed):
+		return taxIdKey(key, TaxId.Normalize(trimmed))
+	case strings.HasPrefix(trimmed, "+") || strings.ContainsAny(trimmed, "() "):
+		return phoneKey(key, phoneMarks.Replace(strings.TrimPrefix(trimmed, "+")), strings.HasPrefix(trimmed, "+"))
+	}
+
+	digits := phoneMarks.Replace(trimmed)
+	if !isDigits(digits) {
+		if len(digits) == 14 && TaxId.Type(digits) == TaxId.Cnpj {
+			return PixKey{Type: Cnpj, Value: strings.ToUpper(digits)}, Error.StarkErrors{}
+		}
+		return PixKey{}, invalid("Pix key %q is not a valid CPF, CNPJ, phone, email or EVP", key)
+	}
+	switch len(digits) {
+	case 14:
+		return taxIdKey(key, digits)
+	case 11:
+		if TaxId.Type(digits) == TaxId.Cpf {
+			return PixKey{Type: Cpf, Value: digits}, Error.StarkErrors{}
+		}
+	}
+	return phoneKey(key, digits, false)
+}
+
+func Detect(key string) string {
+	//	Detect the type of a Pix key
+	//
+	//	Parameters (required):
+	//	- key [string]: Pix key as typed by the user. ex: "+55 11 98888-7777"
+	//
+	//	Return:
+	//	- Cpf, Cnpj, Phone, Email or Evp if the key is valid, otherwise an empty string
+	parsed, err := Parse(key)
+	if err.Errors != nil {
+		return ""
+	}
+	return parsed.Type
+}
+
+func Normalize(key string) (string, Error.StarkErrors) {
+	//	Normalize a Pix key to the format used by the DICT. See Parse for the rules applied
+	parsed, err := Parse(key)
+	return parsed.Value, err
+}
+
+func Get(key string, user user.User) (DictKey.DictKey, Error.StarkErrors) {
+	//	Retrieve the DictKey of a Pix key typed by the user
+	//
+	//	The key is normalized and validated before calling dictkey.Get, so invalid keys return an
+	//	"invalidPixKey" error without calling the Stark Bank API.
+	//
+	//	Parameters (required):
+	//	- key [string]: Pix key as typed by the user. ex: "(11) 98888-7777", "Tony@StarkBank.com" or "722.461.430-04"
+	//
+	//	Parameters (optional):
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
+	//
+	//	Return:
+	//	- DictKey struct that corresponds to the given key
+	parsed, err := Parse(key)
+	if err.Errors != nil {
+		return DictKey.DictKey{}, err
+	}
+	return DictKey.Get(parsed.Value, user)
+}
+
+func ToTransfer(dictKey DictKey.DictKey, amount int) Transfer.Transfer {
+	//	Build a Transfer to the account of a DictKey
+	//
+	//	The account information returned by the DICT is used as is, including the masked tax ID
+	//	and the encrypted branch and account numbers, which are accepted by the Stark Bank API.
+	//	Transfer.Create skips the tax ID validation only for CPFs masked by the DICT, as told by taxid.IsMasked.
+	//
+	//	Parameters (required):
+	//	- dictKey [DictKey struct]: DictKey retrieved with Get or dictkey.Get
+	//	- amount [int]: amount in cents to be transferred. ex: 1234 (= R$ 12.34)
+	//
+	//	Return:
+	//	- Transfer struct ready to be created. Optional fields, such as ExternalId and Tags, may be set before creating it
+	return Transfer.Transfer{
+		Amount:        amount,
+		Name:          dictKey.Name,
+		TaxId:         dictKey.TaxId,
+		BankCode:      dictKey.Ispb,
+		BranchCode:    dictKey.BranchCode,
+		AccountNumber: dictKey.AccountNumber,
+		AccountType:   dictKey.AccountType,
+	}
+}
+
+func taxIdKey(key string, normalized string) (PixKey, Error.StarkErrors) {
+	switch TaxId.Type(normalized) {
+	case TaxId.Cpf:
+		return PixKey{Type: Cpf, Value: normalized}, Error.StarkErrors{}
+	case TaxId.Cnpj:
+		return PixKey{Type: Cnpj, Value: normalized}, Error.StarkErrors{}
+	}
+	return PixKey{}, invalid("Pix key %q is not a valid CPF or CNPJ", key)
+}
+
+func phoneKey(key string, digits string, international bool) (PixKey, Error.StarkErrors) {
+	if !isDigits(digits) {
+		return PixKey{}, invalid("Pix key %q is not a valid phone", key)
+	}
+	if international || len(digits) == 13 {
+		if !strings.HasPrefix(digits, "55") {
+			return PixKey{}, invalid("Pix key %q must be a Brazilian phone starting with +55", key)
+		}
+		digits = digits[2:]
+	}
+	if len(digits) != 11 || !areaCodes[digits[:2]] || digits[2] != '9' {
+		return PixKey{}, invalid("Pix key %q must be a mobile phone with area code and 9 digits. ex: +5511988887777", key)
+	}
+	return PixKey{Type: Phone, Value: "+55" + digits}, Error.StarkErrors{}
+}
+
+func isDigits(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, char := range value {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func invalid(message string, args ...interface{}) Error.StarkErrors {
+	return Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidPixKey", Message: fmt.Sprintf(message, args...)}}}
+}
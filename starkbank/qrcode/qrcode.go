@@ -0,0 +1,407 @@
+package qrcode
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"strings"
+)
+
+//	QR Code struct
+//
+//	A QR Code symbol encoded locally, without calling the Stark Bank API, such as the
+//	image of a BR Code. Content is encoded in byte mode with the smallest version that fits.
+//
+//	Attributes (return-only):
+//	- Version [int]: QR Code version, from 1 to 40. ex: 10
+//	- Level [string]: error correction level: "L", "M", "Q" or "H". ex: "M"
+//	- Mask [int]: mask pattern applied to the symbol, from 0 to 7. ex: 3
+//	- Size [int]: number of modules in each side of the symbol, without the quiet zone. ex: 57
+
+type Code struct {
+	Version  int
+	Level    string
+	Mask     int
+	Size     int
+	modules  [][]bool
+	function [][]bool
+}
+
+func Encode(content string, level string) (Code, Error.StarkErrors) {
+	//	Encode a content into a QR Code symbol
+	//
+	//	Parameters (required):
+	//	- content [string]: content of the QR Code, such as a BR Code. ex: "00020101021226890014br.gov.bcb.pix2567..."
+	//
+	//	Parameters (optional):
+	//	- level [string, default "M"]: error correction level: "L" (7%), "M" (15%), "Q" (25%) or "H" (30%). ex: "H"
+	//
+	//	Return:
+	//	- Code struct
+	if level == "" {
+		level = "M"
+	}
+	levelIndex := -1
+	for i, name := range levels {
+		if strings.EqualFold(level, name) {
+			levelIndex = i
+		}
+	}
+	if levelIndex < 0 {
+		return Code{}, invalid(fmt.Sprintf("QR Code error correction level must be L, M, Q or H, but it is %q", level))
+	}
+
+	data := []byte(content)
+	version := 0
+	for v := 1; v <= 40; v++ {
+		if 4+countBits(v)+8*len(data) <= dataCodewords(v, levelIndex)*8 {
+			version = v
+			break
+		}
+	}
+	if version == 0 {
+		return Code{}, invalid(fmt.Sprintf("QR Code content is too long: %d bytes do not fit level %s", len(data), levels[levelIndex]))
+	}
+
+	code := Code{Version: version, Level: levels[levelIndex], Size: version*4 + 17}
+	code.modules = grid(code.Size)
+	code.function = grid(code.Size)
+	code.drawFunctionPatterns(levelIndex)
+	code.drawCodewords(code.addErrorCorrection(code.dataCodewords(data, levelIndex), levelIndex))
+
+	best := 0
+	bestPenalty := -1
+	for mask := 0; mask < 8; mask++ {
+		code.applyMask(mask)
+		code.drawFormatBits(levelIndex, mask)
+		penalty := code.penalty()
+		if bestPenalty < 0 || penalty < bestPenalty {
+			best, bestPenalty = mask, penalty
+		}
+		code.applyMask(mask)
+	}
+	code.Mask = best
+	code.applyMask(best)
+	code.drawFormatBits(levelIndex, best)
+	code.function = nil
+	return code, Error.StarkErrors{}
+}
+
+func (c Code) IsDark(x int, y int) bool {
+	//	Return true if the module at column x and row y is dark. Modules outside the symbol are light
+	return x >= 0 && y >= 0 && x < c.Size && y < c.Size && c.modules[y][x]
+}
+
+func countBits(version int) int {
+	if version <= 9 {
+		return 8
+	}
+	return 16
+}
+
+func (c *Code) dataCodewords(data []byte, level int) []byte {
+	var bits []bool
+	appendBits := func(value int, length int) {
+		for i := length - 1; i >= 0; i-- {
+			bits = append(bits, (value>>uint(i))&1 == 1)
+		}
+	}
+	appendBits(0x4, 4)
+	appendBits(len(data), countBits(c.Version))
+	for _, b := range data {
+		appendBits(int(b), 8)
+	}
+
+	capacity := dataCodewords(c.Version, level) * 8
+	terminator := capacity - len(bits)
+	if terminator > 4 {
+		terminator = 4
+	}
+	appendBits(0, terminator)
+	appendBits(0, (8-len(bits)%8)%8)
+	for pad := 0xEC; len(bits) < capacity; pad ^= 0xEC ^ 0x11 {
+		appendBits(pad, 8)
+	}
+
+	codewords := make([]byte, len(bits)/8)
+	for i, bit := range bits {
+		if bit {
+			codewords[i/8] |= 1 << uint(7-i%8)
+		}
+	}
+	return codewords
+}
+
+func (c *Code) addErrorCorrection(data []byte, level int) []byte {
+	blocks := errorCorrectionBlocks[level][c.Version]
+	eccLength := eccCodewordsPerBlock[level][c.Version]
+	raw := rawDataModules(c.Version) / 8
+	shortBlocks := blocks - raw%blocks
+	shortLength := raw / blocks
+
+	divisor := reedSolomonDivisor(eccLength)
+	var interleaved [][]byte
+	position := 0
+	for i := 0; i < blocks; i++ {
+		length := shortLength - eccLength
+		if i >= shortBlocks {
+			length++
+		}
+		block := append([]byte{}, data[position:position+length]...)
+		position += length
+		ecc := reedSolomonRemainder(block, divisor)
+		if i < shortBlocks {
+			block = append(block, 0)
+		}
+		interleaved = append(interleaved, append(block, ecc...))
+	}
+
+	var result []byte
+	for i := range interleaved[0] {
+		for j, block := range interleaved {
+			if i != shortLength-eccLength || j >= shortBlocks {
+				result = append(result, block[i])
+			}
+		}
+	}
+	return result
+}
+
+func (c *Code) drawFunctionPatterns(level int) {
+	for i := 0; i < c.Size; i++ {
+		c.setFunction(6, i, i%2 == 0)
+		c.setFunction(i, 6, i%2 == 0)
+	}
+
+	c.drawFinder(3, 3)
+	c.drawFinder(c.Size-4, 3)
+	c.drawFinder(3, c.Size-4)
+
+	positions := alignmentPositions(c.Version)
+	last := len(positions) - 1
+	for i := range positions {
+		for j := range positions {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			c.drawAlignment(positions[i], positions[j])
+		}
+	}
+
+	c.drawFormatBits(level, 0)
+	c.drawVersion()
+}
+
+func (c *Code) drawFinder(x int, y int) {
+	for dy := -4; dy <= 4; dy++ {
+		for dx := -4; dx <= 4; dx++ {
+			distance := max(abs(dx), abs(dy))
+			if x+dx >= 0 && x+dx < c.Size && y+dy >= 0 && y+dy < c.Size {
+				c.setFunction(x+dx, y+dy, distance != 2 && distance != 4)
+			}
+		}
+	}
+}
+
+func (c *Code) drawAlignment(x int, y int) {
+	for dy := -2; dy <= 2; dy++ {
+		for dx := -2; dx <= 2; dx++ {
+			c.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+		}
+	}
+}
+
+func (c *Code) drawFormatBits(level int, mask int) {
+	data := levelFormatBits[level]<<3 | mask
+	remainder := data
+	for i := 0; i < 10; i++ {
+		remainder = (remainder << 1) ^ ((remainder >> 9) * 0x537)
+	}
+	bits := (data<<10 | remainder) ^ 0x5412
+
+	for i := 0; i <= 5; i++ {
+		c.setFunction(8, i, bit(bits, i))
+	}
+	c.setFunction(8, 7, bit(bits, 6))
+	c.setFunction(8, 8, bit(bits, 7))
+	c.setFunction(7, 8, bit(bits, 8))
+	for i := 9; i < 15; i++ {
+		c.setFunction(14-i, 8, bit(bits, i))
+	}
+
+	for i := 0; i < 8; i++ {
+		c.setFunction(c.Size-1-i, 8, bit(bits, i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunction(8, c.Size-15+i, bit(bits, i))
+	}
+	c.setFunction(8, c.Size-8, true)
+}
+
+func (c *Code) drawVersion() {
+	if c.Version < 7 {
+		return
+	}
+	remainder := c.Version
+	for i := 0; i < 12; i++ {
+		remainder = (remainder << 1) ^ ((remainder >> 11) * 0x1F25)
+	}
+	bits := c.Version<<12 | remainder
+	for i := 0; i < 18; i++ {
+		a := c.Size - 11 + i%3
+		b := i / 3
+		c.setFunction(a, b, bit(bits, i))
+		c.setFunction(b, a, bit(bits, i))
+	}
+}
+
+func (c *Code) drawCodewords(data []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vertical := 0; vertical < c.Size; vertical++ {
+			for j := 0; j < 2; j++ {
+				x := right - j
+				y := vertical
+				if (right+1)&2 == 0 {
+					y = c.Size - 1 - vertical
+				}
+				if !c.function[y][x] && i < len(data)*8 {
+					c.modules[y][x] = bit(int(data[i>>3]), 7-(i&7))
+					i++
+				}
+			}
+		}
+	}
+}
+
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if !c.function[y][x] && masked(mask, x, y) {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+func masked(mask int, x int, y int) bool {
+	switch mask {
+	case 0:
+		return (x+y)%2 == 0
+	case 1:
+		return y%2 == 0
+	case 2:
+		return x%3 == 0
+	case 3:
+		return (x+y)%3 == 0
+	case 4:
+		return (x/3+y/2)%2 == 0
+	case 5:
+		return x*y%2+x*y%3 == 0
+	case 6:
+		return (x*y%2+x*y%3)%2 == 0
+	}
+	return ((x+y)%2+x*y%3)%2 == 0
+}
+
+func (c *Code) penalty() int {
+	result := 0
+	dark := 0
+	for i := 0; i < c.Size; i++ {
+		result += c.linePenalty(func(j int) bool { return c.modules[i][j] })
+		result += c.linePenalty(func(j int) bool { return c.modules[j][i] })
+	}
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.modules[y][x] {
+				dark++
+			}
+			if x < c.Size-1 && y < c.Size-1 {
+				color := c.modules[y][x]
+				if c.modules[y][x+1] == color && c.modules[y+1][x] == color && c.modules[y+1][x+1] == color {
+					result += 3
+				}
+			}
+		}
+	}
+	total := c.Size * c.Size
+	deviation := abs(dark*20 - total*10)
+	result += (deviation + total - 1) / total * 10
+	return result - 10
+}
+
+var finderLike = []bool{true, false, true, true, true, false, true}
+
+func (c *Code) linePenalty(module func(int) bool) int {
+	result := 0
+	run := 1
+	for j := 1; j <= c.Size; j++ {
+		if j < c.Size && module(j) == module(j-1) {
+			run++
+			continue
+		}
+		if run >= 5 {
+			result += run - 2
+		}
+		run = 1
+	}
+
+	light := func(from int, to int) bool {
+		for j := from; j < to; j++ {
+			if j >= 0 && j < c.Size && module(j) {
+				return false
+			}
+		}
+		return true
+	}
+	for j := 0; j+len(finderLike) <= c.Size; j++ {
+		matches := true
+		for k, expected := range finderLike {
+			if module(j+k) != expected {
+				matches = false
+				break
+			}
+		}
+		if matches && (light(j-4, j) || light(j+7, j+11)) {
+			result += 40
+		}
+	}
+	return result
+}
+
+func (c *Code) setFunction(x int, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.function[y][x] = true
+}
+
+func grid(size int) [][]bool {
+	rows := make([][]bool, size)
+	for i := range rows {
+		rows[i] = make([]bool, size)
+	}
+	return rows
+}
+
+func bit(value int, i int) bool {
+	return (value>>uint(i))&1 != 0
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+func max(a int, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func invalid(message string) Error.StarkErrors {
+	return Error.StarkErrors{Errors: []Error.StarkError{{Code: "invalidQrcode", Message: message}}}
+}
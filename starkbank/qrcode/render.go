@@ -0,0 +1,134 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+func Png(content string, params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render a QR Code png
+	//
+	//	Encode a content, such as a BR Code, into a QR Code png image locally, without calling the Stark Bank API.
+	//
+	//	Parameters (required):
+	//	- content [string]: content of the QR Code. ex: "00020101021226890014br.gov.bcb.pix2567..."
+	//
+	//	Parameters (optional):
+	//	- size [int, default 7]: number of pixels in each "box" of the QR code. Minimum = 1, maximum = 50. ex: 12
+	//	- level [string, default "M"]: error correction level: "L", "M", "Q" or "H". ex: "H"
+	//	- quietZone [int, default 4]: number of light "boxes" around the QR code. Minimum = 0, maximum = 50. ex: 2
+	//
+	//	Return:
+	//	- QR Code .png blob
+	code, err := encodeWithParams(content, params)
+	if err.Errors != nil {
+		return nil, err
+	}
+	return code.Png(params)
+}
+
+func Svg(content string, params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render a QR Code svg
+	//
+	//	Encode a content, such as a BR Code, into a QR Code svg image locally, without calling the Stark Bank API.
+	//
+	//	Parameters (required):
+	//	- content [string]: content of the QR Code. ex: "00020101021226890014br.gov.bcb.pix2567..."
+	//
+	//	Parameters (optional):
+	//	- size [int, default 7]: number of pixels in each "box" of the QR code. Minimum = 1, maximum = 50. ex: 12
+	//	- level [string, default "M"]: error correction level: "L", "M", "Q" or "H". ex: "H"
+	//	- quietZone [int, default 4]: number of light "boxes" around the QR code. Minimum = 0, maximum = 50. ex: 2
+	//
+	//	Return:
+	//	- QR Code .svg blob
+	code, err := encodeWithParams(content, params)
+	if err.Errors != nil {
+		return nil, err
+	}
+	return code.Svg(params)
+}
+
+func (c Code) Png(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the Code as a png image, using the "size" and "quietZone" params described in Png
+	size, quietZone, err := dimensions(params)
+	if err.Errors != nil {
+		return nil, err
+	}
+	side := (c.Size + 2*quietZone) * size
+	img := image.NewGray(image.Rect(0, 0, side, side))
+	for y := 0; y < side; y++ {
+		for x := 0; x < side; x++ {
+			shade := color.Gray{Y: 255}
+			if c.IsDark(x/size-quietZone, y/size-quietZone) {
+				shade = color.Gray{Y: 0}
+			}
+			img.SetGray(x, y, shade)
+		}
+	}
+	var buffer bytes.Buffer
+	if encodeErr := png.Encode(&buffer, img); encodeErr != nil {
+		return nil, Error.UnknownError(encodeErr.Error())
+	}
+	return buffer.Bytes(), Error.StarkErrors{}
+}
+
+func (c Code) Svg(params map[string]interface{}) ([]byte, Error.StarkErrors) {
+	//	Render the Code as an svg image, using the "size" and "quietZone" params described in Svg
+	size, quietZone, err := dimensions(params)
+	if err.Errors != nil {
+		return nil, err
+	}
+	modules := c.Size + 2*quietZone
+	var path strings.Builder
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.IsDark(x, y) {
+				fmt.Fprintf(&path, "M%d,%dh1v1h-1z", x+quietZone, y+quietZone)
+			}
+		}
+	}
+	svg := fmt.Sprintf(
+		`<svg xmlns="http://www.w3.org/2000/svg" version="1.1" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+			`<rect width="100%%" height="100%%" fill="#FFFFFF"/><path d="%s" fill="#000000"/></svg>`,
+		modules*size, modules*size, modules, modules, path.String(),
+	)
+	return []byte(svg), Error.StarkErrors{}
+}
+
+func encodeWithParams(content string, params map[string]interface{}) (Code, Error.StarkErrors) {
+	level := ""
+	if value, ok := params["level"]; ok {
+		level, ok = value.(string)
+		if !ok {
+			return Code{}, invalid(fmt.Sprintf("QR Code level must be a string, but it is %v", value))
+		}
+	}
+	return Encode(content, level)
+}
+
+func dimensions(params map[string]interface{}) (int, int, Error.StarkErrors) {
+	size, err := intParam(params, "size", 7, 1, 50)
+	if err.Errors != nil {
+		return 0, 0, err
+	}
+	quietZone, err := intParam(params, "quietZone", 4, 0, 50)
+	return size, quietZone, err
+}
+
+func intParam(params map[string]interface{}, name string, fallback int, minimum int, maximum int) (int, Error.StarkErrors) {
+	value, ok := params[name]
+	if !ok {
+		return fallback, Error.StarkErrors{}
+	}
+	number, ok := value.(int)
+	if !ok || number < minimum || number > maximum {
+		return 0, invalid(fmt.Sprintf("QR Code %s must be an integer from %d to %d, but it is %v", name, minimum, maximum, value))
+	}
+	return number, Error.StarkErrors{}
+}
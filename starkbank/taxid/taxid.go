@@ -0,0 +1,182 @@
+package taxid
+
+import (
+	"fmt"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"strings"
+)
+
+//	Tax ID helpers
+//
+//	Validate, normalize and format Brazilian tax IDs: CPF for individuals and CNPJ for
+//	companies, including the alphanumeric CNPJ, whose first 12 characters may be
+//	uppercase letters or digits. Formatted and unformatted tax IDs are accepted.
+
+const (
+	Cpf  = "cpf"
+	Cnpj = "cnpj"
+)
+
+const cpfMask = "***.ddd.ddd-**"
+
+var cnpjFirstWeights = []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+var cnpjSecondWeights = []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+func Normalize(taxId string) string {
+	//	Remove the formatting of a tax ID
+	//
+	//	Parameters (required):
+	//	- taxId [string]: CPF or CNPJ with or without formatting. ex: "012.345.678-90" or "12.abc.345/01de-35"
+	//
+	//	Return:
+	//	- tax ID without dots, slashes, hyphens or spaces and with uppercase letters. ex: "01234567890" or "12ABC34501DE35"
+	return strings.ToUpper(strings.NewReplacer(".", "", "/", "", "-", "", " ", "").Replace(taxId))
+}
+
+func Type(taxId string) string {
+	//	Detect the type of a tax ID
+	//
+	//	Parameters (required):
+	//	- taxId [string]: CPF or CNPJ with or without formatting. ex: "20.018.183/0001-80"
+	//
+	//	Return:
+	//	- Cpf or Cnpj if the tax ID is valid, otherwise an empty string
+	normalized := Normalize(taxId)
+	if len(normalized) == 11 && isCpf(normalized) {
+		return Cpf
+	}
+	if len(normalized) == 14 && isCnpj(normalized) {
+		return Cnpj
+	}
+	return ""
+}
+
+func IsValid(taxId string) bool {
+	//	Return true if the tax ID is a valid CPF or CNPJ
+	return Type(taxId) != ""
+}
+
+func Validate(taxId string) Error.StarkErrors {
+	//	Validate a tax ID
+	//
+	//	Parameters (required):
+	//	- taxId [string]: CPF or CNPJ with or without formatting. ex: "012.345.678-90"
+	//
+	//	Return:
+	//	- "invalidTaxId" error if the tax ID is not a valid CPF or CNPJ
+	if IsValid(taxId) {
+		return Error.StarkErrors{}
+	}
+	return Error.StarkErrors{Errors: []Error.StarkError{{
+		Code:    "invalidTaxId",
+		Message: fmt.Sprintf("Tax ID %q is not a valid CPF or CNPJ", taxId),
+	}}}
+}
+
+func IsMasked(taxId string) bool {
+	//	Return true if the tax ID is a CPF masked by the DICT, such as the TaxId of a DictKey. ex: "***.345.678-**"
+	//	CNPJs are returned in full by the DICT, so they are never masked.
+	if len(taxId) != len(cpfMask) {
+		return false
+	}
+	for i := range cpfMask {
+		if (cpfMask[i] == 'd' && (taxId[i] < '0' || taxId[i] > '9')) || (cpfMask[i] != 'd' && taxId[i] != cpfMask[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func Check(taxIds ...string) Error.StarkErrors {
+	//	Validate the informed tax IDs, ignoring empty ones
+	//
+	//	Parameters (required):
+	//	- taxIds [strings]: CPFs or CNPJs with or without formatting. ex: "012.345.678-90", ""
+	//
+	//	Return:
+	//	- "invalidTaxId" errors of every invalid tax ID
+	var errors []Error.StarkError
+	for _, taxId := range taxIds {
+		if taxId == "" {
+			continue
+		}
+		errors = append(errors, Validate(taxId).Errors...)
+	}
+	return Error.StarkErrors{Errors: errors}
+}
+
+func Format(taxId string) (string, Error.StarkErrors) {
+	//	Format a tax ID
+	//
+	//	Parameters (required):
+	//	- taxId [string]: CPF or CNPJ with or without formatting. ex: "01234567890" or "12ABC34501DE35"
+	//
+	//	Return:
+	//	- formatted tax ID. ex: "012.345.678-90" or "12.ABC.345/01DE-35"
+	normalized := Normalize(taxId)
+	switch Type(normalized) {
+	case Cpf:
+		return fmt.Sprintf("%s.%s.%s-%s", normalized[0:3], normalized[3:6], normalized[6:9], normalized[9:11]), Error.StarkErrors{}
+	case Cnpj:
+		return fmt.Sprintf("%s.%s.%s/%s-%s", normalized[0:2], normalized[2:5], normalized[5:8], normalized[8:12], normalized[12:14]), Error.StarkErrors{}
+	}
+	return "", Validate(taxId)
+}
+
+func isCpf(taxId string) bool {
+	values := make([]int, len(taxId))
+	for i, char := range taxId {
+		if char < '0' || char > '9' {
+			return false
+		}
+		values[i] = int(char - '0')
+	}
+	if repeated(taxId) {
+		return false
+	}
+	return values[9] == cpfDigit(values[:9]) && values[10] == cpfDigit(values[:10])
+}
+
+func cpfDigit(values []int) int {
+	sum := 0
+	for i, value := range values {
+		sum += value * (len(values) + 1 - i)
+	}
+	return checkDigit(sum)
+}
+
+func isCnpj(taxId string) bool {
+	values := make([]int, len(taxId))
+	for i, char := range taxId {
+		isDigit := char >= '0' && char <= '9'
+		isLetter := char >= 'A' && char <= 'Z'
+		if !isDigit && (!isLetter || i >= 12) {
+			return false
+		}
+		values[i] = int(char - '0')
+	}
+	if repeated(taxId) {
+		return false
+	}
+	return values[12] == cnpjDigit(values[:12], cnpjFirstWeights) && values[13] == cnpjDigit(values[:13], cnpjSecondWeights)
+}
+
+func cnpjDigit(values []int, weights []int) int {
+	sum := 0
+	for i, value := range values {
+		sum += value * weights[i]
+	}
+	return checkDigit(sum)
+}
+
+func checkDigit(sum int) int {
+	remainder := sum % 11
+	if remainder < 2 {
+		return 0
+	}
+	return 11 - remainder
+}
+
+func repeated(taxId string) bool {
+	return strings.Count(taxId, taxId[:1]) == len(taxId)
+}
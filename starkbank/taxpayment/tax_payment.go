@@ -2,6 +2,7 @@ package taxpayment
 
 import (
 	"encoding/json"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -22,13 +23,13 @@ import (
 //	- BarCode [string, default nil]: Bar code number that describes the payment. Either 'line' or 'barCode' parameters are required. If both are sent, they must match. ex: "83660000001084301380074119002551100010601813"
 //
 //	Parameters (optional):
-//	- Scheduled [time.Time, default today]: payment scheduled date. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
+//	- Scheduled [date.Date, default today]: payment scheduled date. ex: date.New(2020, time.March, 10),
 //	- Tags [slice of strings]: slice of strings for tagging. ex: []string{"John", "Paul"}
 //
 //	Attributes (return-only):
 //	- Id [string]: unique id returned when payment is created. ex: "5656565656565656"
 //	- Type [string]: tax type. ex: "das"
-//	- Status [string]: current payment status. ex: "success" or "failed"
+//	- Status [Status]: current payment status. ex: "created", "processing", "success", "failed" or "canceled"
 //	- Amount [int]: amount automatically calculated from line or bar_code. ex: 23456 (= R$ 234.56)
 //	- Fee [int]: fee charged when tax payment is created. ex: 200 (= R$ 2.00)
 //	- TransactionIds [slice of strings]: ledger transaction ids linked to this TaxPayment. []string{"19827356981273"}
@@ -40,9 +41,9 @@ type TaxPayment struct {
 	Line           string     `json:",omitempty"`
 	BarCode        string     `json:",omitempty"`
 	Description    string     `json:",omitempty"`
-	Scheduled      *time.Time `json:",omitempty"`
+	Scheduled      *Date.Date `json:",omitempty"`
 	Tags           []string   `json:",omitempty"`
-	Status         string     `json:",omitempty"`
+	Status         Status     `json:",omitempty"`
 	Amount         int        `json:",omitempty"`
 	Fee            int        `json:",omitempty"`
 	Type           string     `json:",omitempty"`
@@ -123,11 +124,11 @@ func Query(params map[string]interface{}, user user.User) (chan TaxPayment, chan
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: date filter for structs created only after specified date.
-	//		- before [string, default nil]: date filter for structs created only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for structs created only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for structs created only before specified date.
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: taxpayment.Success or []taxpayment.Status{taxpayment.Success, taxpayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -165,11 +166,11 @@ func Page(params map[string]interface{}, user user.User) ([]TaxPayment, string,
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: cursor returned on the previous page function call
 	//		- limit [int, default 100]: maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: date filter for structs created only after specified date.
-	//		- before [string, default nil]: date filter for structs created only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for structs created only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for structs created only before specified date.
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: taxpayment.Success or []taxpayment.Status{taxpayment.Success, taxpayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -100,8 +100,8 @@ func Query(params map[string]interface{}, user user.User) (chan Transaction, cha
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: maximum number of objects to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: date filter for objects created only after specified date.
-	//		- before [string, default nil]: date filter for objects created only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for objects created only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for objects created only before specified date.
 	//		- tags [slice of strings, default nil]: tags to filter retrieved objects. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: list of ids to filter retrieved objects. ex: []string{"5656565656565656", "4545454545454545"}
 	//		- status [string, default nil]: filter for status of retrieved objects. ex: "success"
@@ -142,8 +142,8 @@ func Page(params map[string]interface{}, user user.User) ([]Transaction, string,
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: cursor returned on the previous page function call
 	//		- limit [int, default 100]: maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: date filter for structs created only after specified date.
-	//		- before [string, default nil]: date filter for structs created only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for structs created only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for structs created only before specified date.
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: list of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success"
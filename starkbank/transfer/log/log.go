@@ -62,8 +62,8 @@ func Query(params map[string]interface{}, user user.User) (chan Log, chan Error.
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: date filter for structs created only after specified date.
-	//		- before [string, default nil]: date filter for structs created only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for structs created only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for structs created only before specified date.
 	//		- types [slice of strings, default nil]: filter retrieved structs by event types. ex: []string{"processing", "success"}
 	//		- transferIds [slice of strings, default nil]: slice of Transfer ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -103,8 +103,8 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: cursor returned on the previous page function call
 	//		- limit [int, default 100]: maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: date filter for structs created only after specified date.
-	//		- before [string, default nil]: date filter for structs created only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for structs created only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for structs created only before specified date.
 	//		- types [slice of strings, default nil]: filter retrieved structs by types. ex: []string{"success", "failed"}
 	//		- transferIds [slice of strings, default nil]: slice of Transfer ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
@@ -120,3 +120,29 @@ func Page(params map[string]interface{}, user user.User) ([]Log, string, Error.S
 	}
 	return transferLogs, cursor, err
 }
+
+var _ utils.Log = Log{}
+
+func (l Log) LogId() string {
+	return l.Id
+}
+
+func (l Log) LogType() string {
+	return l.Type
+}
+
+func (l Log) LogCreated() *time.Time {
+	return l.Created
+}
+
+func (l Log) EntityId() string {
+	return l.Transfer.Id
+}
+
+func (l Log) EntityStatus() string {
+	return string(l.Transfer.Status)
+}
+
+func (l Log) LogErrors() []string {
+	return l.Errors
+}
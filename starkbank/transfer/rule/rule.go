@@ -1,8 +1,15 @@
 package rule
 
+import (
+	"fmt"
+	"github.com/starkbank/sdk-go/starkbank/utils"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+)
+
 //	Transfer.Rule struct
 //
 //	The Transfer.Rule struct modifies the behavior of Transfer structs when passed as an argument upon their creation.
+//	Use the constructors, such as ResendingLimit, to build rules that are checked before the Transfers are sent to the API.
 //
 //	Attributes (return-only):
 //	- Key [string]: Rule to be customized, describes what Transfer behavior will be altered. ex: "resendingLimit"
@@ -12,3 +19,36 @@ type Rule struct {
 	Key   string `json:",omitempty"`
 	Value interface{} `json:",omitempty"`
 }
+
+const ResendingLimitKey = "resendingLimit"
+
+func ResendingLimit(limit int) Rule {
+	//	Build a rule with the number of times the Transfer will be resent if it fails. ex: rule.ResendingLimit(5)
+	return Rule{Key: ResendingLimitKey, Value: limit}
+}
+
+func (r Rule) ResendingLimit() (int, bool) {
+	//	Get the resending limit of a "resendingLimit" rule. The second return is false for other rules
+	limit, ok := utils.ToInt(r.Value)
+	return limit, ok && r.Key == ResendingLimitKey
+}
+
+func Check(rules []Rule) Error.StarkErrors {
+	//	Validate the value of each rule with a constructor, returning an "invalidRule" error for each invalid one.
+	//	Rules with other keys are sent to the API unchanged.
+	return utils.CheckRules(len(rules), func(index int) string {
+		rule := rules[index]
+		switch rule.Key {
+		case ResendingLimitKey:
+			if limit, ok := utils.ToInt(rule.Value); !ok || limit < 0 {
+				return fmt.Sprintf("Transfer rule %q must be a non-negative integer, but it is %v", rule.Key, rule.Value)
+			}
+		}
+		return ""
+	})
+}
+
+func (r *Rule) UnmarshalJSON(data []byte) (err error) {
+	r.Key, r.Value, err = utils.UnmarshalRule(data, map[string]interface{}{ResendingLimitKey: 0})
+	return err
+}
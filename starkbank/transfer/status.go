@@ -0,0 +1,46 @@
+package transfer
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	Transfer Status type
+//
+//	Transfers are created, possibly waiting for their scheduled date, and are then processed until
+//	they succeed or fail. Only created Transfers may be canceled.
+
+type Status string
+
+const (
+	Created    Status = "created"
+	Processing Status = "processing"
+	Success    Status = "success"
+	Failed     Status = "failed"
+	Canceled   Status = "canceled"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"success"},
+	Transitions: map[string][]string{
+		"created":    {"processing", "failed", "canceled"},
+		"processing": {"success", "failed"},
+		"success":    {},
+		"failed":     {},
+		"canceled":   {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "success"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
@@ -3,6 +3,7 @@ package transfer
 import (
 	"encoding/json"
 	"github.com/starkbank/sdk-go/starkbank/transfer/rule"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -30,12 +31,12 @@ import (
 //	- Description [string, default nil]: optional description to override default description to be shown in the bank statement. ex: "Payment for service #1234"
 //	- DisplayDescription [string, default nil]: optional description to be shown in the receiver bank interface. ex: "Payment for service 1234"
 //  - Tags [slice of strings, default nil]: slice of strings for reference when searching for transfers. ex: []string{"John", "Paul"}
-//	- Rules [slice of Transfer.Rule structs, default nil]: slice of Transfer.Rule structs for modifying transfer behavior. ex: []rule.Rule{{Key: "resendingLimit", Value: 5}},
+//	- Rules [slice of Transfer.Rule structs, default nil]: slice of Transfer.Rule structs for modifying transfer behavior. ex: []rule.Rule{rule.ResendingLimit(5)},
 //
 //	Attributes (return-only):
 //	- Id [string]: unique id returned when the transfer is created. ex: "5656565656565656"
 //	- Fee [int]: fee charged when the Transfer is processed. ex: 200 (= R$ 2.00)
-//	- Status [string]: current transfer status. ex: "success" or "failed"
+//	- Status [Status]: current transfer status. ex: "created", "processing", "success", "failed" or "canceled"
 //	- TransactionIds [slice of strings]: ledger Transaction IDs linked to this Transfer (if there are two, the second is the chargeback). ex: []string{"19827356981273"}
 //	- Metadata [map[string]interface{}]: object used to store additional information about the Transfer struct.
 //	- Created [time.Time]: creation datetime for the transfer. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
@@ -57,7 +58,7 @@ type Transfer struct {
 	Tags               []string               `json:",omitempty"`
 	Rules              []rule.Rule            `json:",omitempty"`
 	Fee                int                    `json:",omitempty"`
-	Status             string                 `json:",omitempty"`
+	Status             Status                 `json:",omitempty"`
 	TransactionIds     []string               `json:",omitempty"`
 	Metadata           map[string]interface{} `json:",omitempty"`
 	Created            *time.Time             `json:",omitempty"`
@@ -79,6 +80,17 @@ func Create(transfers []Transfer, user user.User) ([]Transfer, Error.StarkErrors
 	//
 	//	Return:
 	//	- Slice of Transfer structs with updated attributes
+	var errors []Error.StarkError
+	for _, transfer := range transfers {
+		if !TaxId.IsMasked(transfer.TaxId) {
+			errors = append(errors, TaxId.Check(transfer.TaxId).Errors...)
+		}
+		errors = append(errors, rule.Check(transfer.Rules).Errors...)
+	}
+	if errors != nil {
+		return transfers, Error.StarkErrors{Errors: errors}
+	}
+
 	create, err := utils.Multi(resource, transfers, nil, user)
 	unmarshalError := json.Unmarshal(create, &transfers)
 	if unmarshalError != nil {
@@ -156,10 +168,10 @@ func Query(params map[string]interface{}, user user.User) (chan Transfer, chan E
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: date filter for structs created or updated only after specified date.
-	//		- before [string, default nil]: date filter for structs created or updated only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for structs created or updated only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for structs created or updated only before specified date.
 	//		- transactionIds [slice of strings, default nil]: slice of transaction IDs linked to the desired transfers. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success" or "failed"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: transfer.Success or []transfer.Status{transfer.Success, transfer.Failed}
 	//		- taxId [string, default nil]: filter for transfers sent to the specified tax ID. ex: "012.345.678-90"
 	//		- sort [string, default "-created"]: sort order considered in response. Valid options are "created", "-created", "updated" or "-updated".
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
@@ -201,10 +213,10 @@ func Page(params map[string]interface{}, user user.User) ([]Transfer, string, Er
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: cursor returned on the previous page function call
 	//		- limit [int, default 100]: maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: date filter for structs created or updated only after specified date.
-	//		- before [string, default nil]: date filter for structs created or updated only before specified date.
+	//		- after [string or date.Date, default nil]: date filter for structs created or updated only after specified date.
+	//		- before [string or date.Date, default nil]: date filter for structs created or updated only before specified date.
 	//		- transactionIds [slice of strings, default nil]: slice of transaction IDs linked to the desired transfers. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success" or "failed"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: transfer.Success or []transfer.Status{transfer.Success, transfer.Failed}
 	//		- taxId [string, default nil]: filter for transfers sent to the specified tax ID. ex: "012.345.678-90"
 	//		- sort [string, default "-created"]: sort order considered in response. Valid options are "created", "-created", "updated" or "-updated".
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
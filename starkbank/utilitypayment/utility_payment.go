@@ -2,6 +2,7 @@ package utilitypayment
 
 import (
 	"encoding/json"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
 	"github.com/starkbank/sdk-go/starkbank/utils"
 	Error "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
@@ -22,12 +23,12 @@ import (
 //	- BarCode [string, default nil]: Bar code number that describes the payment. Either 'line' or 'barCode' parameters are required. If both are sent, they must match. ex: "34195819600000000621090063571277307144464000"
 //
 //	Parameters (optional):
-//	- Scheduled [time.Time, default today]: payment scheduled date. ex: time.Date(2020, 3, 10, 0, 0, 0, 0, time.UTC),
+//	- Scheduled [date.Date, default today]: payment scheduled date. ex: date.New(2020, time.March, 10),
 //	- Tags [slice of strings, default nil]: slice of strings for tagging. ex: []string{"John", "Paul"}
 //
 //	Attributes (return-only):
 //	- Id [string]: unique id returned when payment is created. ex: "5656565656565656"
-//	- Status [string]: current payment status. ex: "success" or "failed"
+//	- Status [Status]: current payment status. ex: "created", "processing", "success", "failed" or "canceled"
 //	- Amount [int]: amount automatically calculated from line or barCode. ex: 23456 (= R$ 234.56)
 //	- Fee [int]: fee charged when utility payment is created. ex: 200 (= R$ 2.00)
 //  - Type [string]: payment type. ex: "utility"
@@ -40,9 +41,9 @@ type UtilityPayment struct {
 	Line           string     `json:",omitempty"`
 	BarCode        string     `json:",omitempty"`
 	Description    string     `json:",omitempty"`
-	Scheduled      *time.Time `json:",omitempty"`
+	Scheduled      *Date.Date `json:",omitempty"`
 	Tags           []string   `json:",omitempty"`
-	Status         string     `json:",omitempty"`
+	Status         Status     `json:",omitempty"`
 	Amount         int        `json:",omitempty"`
 	Fee            int        `json:",omitempty"`
 	Type           string     `json:",omitempty"`
@@ -121,11 +122,11 @@ func Query(params map[string]interface{}, user user.User) (chan UtilityPayment,
 	//	Parameters (optional):
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- limit [int, default nil]: maximum number of structs to be retrieved. Unlimited if nil. ex: 35
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: utilitypayment.Success or []utilitypayment.Status{utilitypayment.Success, utilitypayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
@@ -163,11 +164,11 @@ func Page(params map[string]interface{}, user user.User) ([]UtilityPayment, stri
 	//  - params [map[string]interface{}, default nil]: map of parameters for the query
 	//		- cursor [string, default nil]: cursor returned on the previous page function call
 	//		- limit [int, default 100]: maximum number of structs to be retrieved. It must be an int between 1 and 100. ex: 50
-	//		- after [string, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
-	//		- before [string, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//		- after [string or date.Date, default nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
 	//		- tags [slice of strings, default nil]: tags to filter retrieved structs. ex: []string{"John", "Paul"}
 	//		- ids [slice of strings, default nil]: slice of ids to filter retrieved structs. ex: []string{"5656565656565656", "4545454545454545"}
-	//		- status [string, default nil]: filter for status of retrieved structs. ex: "success"
+	//		- status [Status or slice of Statuses, default nil]: filter for status of retrieved structs. ex: utilitypayment.Success or []utilitypayment.Status{utilitypayment.Success, utilitypayment.Failed}
 	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
 	//
 	//	Return:
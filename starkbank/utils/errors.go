@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"fmt"
+)
+
+func ErrorMessages(errors []interface{}) []string {
+	var messages []string
+	for _, err := range errors {
+		switch value := err.(type) {
+		case string:
+			messages = append(messages, value)
+		case map[string]interface{}:
+			if message, ok := value["message"]; ok {
+				messages = append(messages, fmt.Sprint(message))
+				continue
+			}
+			messages = append(messages, fmt.Sprint(value))
+		default:
+			messages = append(messages, fmt.Sprint(value))
+		}
+	}
+	return messages
+}
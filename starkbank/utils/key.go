@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/publickey"
+	Signature "github.com/starkbank/ecdsa-go/v2/ellipticcurve/signature"
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"sync"
+	"time"
+)
+
+//	PublicKeyProvider interface
+//
+//	A PublicKeyProvider supplies the public key used to verify the digital signature of
+//	the content Stark Bank sends to your endpoints, such as webhook Events and
+//	CorporatePurchase authorization requests. When a signature does not check out,
+//	the key is requested again with refresh set to true to handle key rotation.
+
+type PublicKeyProvider interface {
+	PublicKey(user user.User, refresh bool) (publickey.PublicKey, Errors.StarkErrors)
+}
+
+//	PublicKeyProvider used by ParseAndVerify. Replace it to inject your own key, ex: in offline tests
+var KeyProvider PublicKeyProvider = &CachedPublicKeyProvider{}
+
+//	CachedPublicKeyProvider struct
+//
+//	Fetches the Stark Bank public key from the API once and keeps it in memory
+//	until a refresh is requested. Concurrent first calls wait for a single fetch.
+//	Refreshes are limited to one per RefreshInterval, so content with forged
+//	signatures cannot flood the API, and are fetched without blocking the
+//	verifications that use the cached key.
+//
+//	Parameters (optional):
+//	- RefreshInterval [time.Duration, default 1 minute]: minimum time between two key refreshes. ex: 5 * time.Minute
+//	- Fetch [func(user.User) (publickey.PublicKey, Errors.StarkErrors), default nil]: function used to get the key. Requests it from the Stark Bank API if nil
+
+type CachedPublicKeyProvider struct {
+	RefreshInterval time.Duration
+	Fetch           func(user user.User) (publickey.PublicKey, Errors.StarkErrors)
+	mutex           sync.Mutex
+	key             *publickey.PublicKey
+	fetched         time.Time
+	fetching        chan struct{}
+}
+
+const defaultRefreshInterval = time.Minute
+
+func (p *CachedPublicKeyProvider) PublicKey(user user.User, refresh bool) (publickey.PublicKey, Errors.StarkErrors) {
+	p.mutex.Lock()
+	for p.key == nil && p.fetching != nil {
+		fetching := p.fetching
+		p.mutex.Unlock()
+		<-fetching
+		p.mutex.Lock()
+	}
+	first := p.key == nil
+	if first {
+		p.fetching = make(chan struct{})
+	} else {
+		interval := p.RefreshInterval
+		if interval <= 0 {
+			interval = defaultRefreshInterval
+		}
+		if !refresh || time.Since(p.fetched) < interval {
+			key := *p.key
+			p.mutex.Unlock()
+			return key, Errors.StarkErrors{}
+		}
+		p.fetched = time.Now()
+	}
+	fetch := p.Fetch
+	p.mutex.Unlock()
+
+	if fetch == nil {
+		fetch = fetchPublicKey
+	}
+	key, err := fetch(user)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if first {
+		close(p.fetching)
+		p.fetching = nil
+	}
+	if err.Errors != nil {
+		return publickey.PublicKey{}, err
+	}
+	p.key = &key
+	p.fetched = time.Now()
+	return key, Errors.StarkErrors{}
+}
+
+//	StaticPublicKeyProvider struct
+//
+//	Always supplies the same public key. Useful to verify content signed locally.
+//
+//	Parameters (required):
+//	- Key [publickey.PublicKey]: public key used to verify signatures. ex: privateKey.PublicKey()
+
+type StaticPublicKeyProvider struct {
+	Key publickey.PublicKey
+}
+
+func (p StaticPublicKeyProvider) PublicKey(user user.User, refresh bool) (publickey.PublicKey, Errors.StarkErrors) {
+	return p.Key, Errors.StarkErrors{}
+}
+
+func Verify(content string, signature string, user user.User) (string, Errors.StarkErrors) {
+	parsedSignature, ok := parseSignature(signature)
+	if !ok {
+		return "", Errors.InvalidSignatureError("The provided signature is not valid")
+	}
+
+	key, err := KeyProvider.PublicKey(user, false)
+	if err.Errors != nil {
+		return "", err
+	}
+	if ecdsa.Verify(content, parsedSignature, &key) {
+		return content, Errors.StarkErrors{}
+	}
+
+	key, err = KeyProvider.PublicKey(user, true)
+	if err.Errors != nil {
+		return "", err
+	}
+	if ecdsa.Verify(content, parsedSignature, &key) {
+		return content, Errors.StarkErrors{}
+	}
+	return "", Errors.InvalidSignatureError("The provided signature and content do not match the public key")
+}
+
+func parseSignature(signature string) (parsed Signature.Signature, ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return Signature.FromBase64(signature), true
+}
+
+func fetchPublicKey(user user.User) (key publickey.PublicKey, err Errors.StarkErrors) {
+	var data struct {
+		PublicKeys []struct {
+			Content string
+		}
+	}
+	response, err := GetRaw("/public-key", nil, user, "", true)
+	if err.Errors != nil {
+		return key, err
+	}
+
+	unmarshalError := json.Unmarshal(response.Content, &data)
+	if unmarshalError != nil || len(data.PublicKeys) == 0 {
+		return key, Errors.InputError(string(response.Content))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = Errors.UnknownError(fmt.Sprintf("invalid public key: %v", r))
+		}
+	}()
+	return publickey.FromPem(data.PublicKeys[0].Content), Errors.StarkErrors{}
+}
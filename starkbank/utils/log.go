@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"time"
+)
+
+//	Log interface
+//
+//	Implemented by the Log struct of every resource, such as transfer.Log, invoice.Log
+//	or corporatecard.Log, so that handlers and storage can be written once for all of them.
+//	Creation datetime and errors are exposed as LogCreated and LogErrors, since the
+//	structs already have Created and Errors fields.
+//
+//	Methods:
+//	- LogId [string]: unique id of the log. ex: "5656565656565656"
+//	- LogType [string]: type of the event which triggered the log creation. ex: "processing" or "success"
+//	- LogCreated [time.Time]: creation datetime for the log. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
+//	- EntityId [string]: unique id of the entity to which the log refers. ex: "4848484848484848"
+//	- EntityStatus [string]: status of the entity when the log was created. ex: "success"
+//	- LogErrors [slice of strings]: errors linked to the log. Empty for resources whose logs have no errors
+
+type Log interface {
+	LogId() string
+	LogType() string
+	LogCreated() *time.Time
+	EntityId() string
+	EntityStatus() string
+	LogErrors() []string
+}
@@ -2,27 +2,15 @@ package utils
 
 import (
 	"strings"
-	"github.com/starkbank/sdk-go/starkbank"
 	Errors "github.com/starkinfra/core-go/starkcore/error"
 	"github.com/starkinfra/core-go/starkcore/user/user"
-	"github.com/starkinfra/core-go/starkcore/utils/parse"
 )
 
-func ParseAndVerify(content string, signature string, key string, user user.User) (string, Errors.StarkErrors) {
-	if user == nil {
-		response, err := parse.ParseAndVerify(content, signature, starkbank.SdkVersion, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, starkbank.Host, starkbank.User, key)
-		if err.Errors != nil {
-			return "", err
-		}
-		return response.(string), err
-	}
-	response, err := parse.ParseAndVerify(content, signature, starkbank.SdkVersion, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, starkbank.Host, user, key)
-	if err.Errors != nil {
-		return "", err
-	}
-	return response.(string), err
+func ParseAndVerify(content string, signature string, user user.User) (string, Errors.StarkErrors) {
+	//	Verify the content signature with the KeyProvider, returning the content unchanged if it is valid
+	return Verify(content, signature, user)
 }
 
 func ReplaceEmptyStringField(jsonStr, pattern, replacement string) string {
 	return strings.ReplaceAll(jsonStr, pattern, replacement)
-}
\ No newline at end of file
+}
@@ -10,16 +10,16 @@ import (
 
 func Page(resource map[string]string, params map[string]interface{}, user user.User) ([]byte, string, Errors.StarkErrors) {
 	if user == nil {
-		return rest.GetPage(starkbank.SdkVersion, starkbank.Host, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, starkbank.User, resource, params)
+		return rest.GetPage(starkbank.SdkVersion, starkbank.Host, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, starkbank.User, resource, CastParams(params))
 	}
-	return rest.GetPage(starkbank.SdkVersion, starkbank.Host, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, user, resource, params)
+	return rest.GetPage(starkbank.SdkVersion, starkbank.Host, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, user, resource, CastParams(params))
 }
 
 func Query(resource map[string]string, params map[string]interface{}, user user.User) (chan map[string]interface{}, chan Errors.StarkErrors) {
 	if user == nil {
-		return rest.GetStream(starkbank.SdkVersion, starkbank.Host, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, starkbank.User, resource, params)
+		return rest.GetStream(starkbank.SdkVersion, starkbank.Host, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, starkbank.User, resource, CastParams(params))
 	}
-	return rest.GetStream(starkbank.SdkVersion, starkbank.Host, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, user, resource, params)
+	return rest.GetStream(starkbank.SdkVersion, starkbank.Host, starkbank.ApiVersion, starkbank.Language, starkbank.Timeout, user, resource, CastParams(params))
 }
 
 func Get(resource map[string]string, id string, query map[string]interface{}, user user.User) ([]byte, Errors.StarkErrors) {
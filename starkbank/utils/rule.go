@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"encoding/json"
+	Errors "github.com/starkinfra/core-go/starkcore/error"
+	"reflect"
+)
+
+func CheckRules(count int, check func(index int) string) Errors.StarkErrors {
+	//	Run the key-specific check of each of the count rules of a resource, returning an "invalidRule"
+	//	error for each non-empty message
+	var errors []Errors.StarkError
+	for index := 0; index < count; index++ {
+		if message := check(index); message != "" {
+			errors = append(errors, Errors.StarkError{Code: "invalidRule", Message: message})
+		}
+	}
+	return Errors.StarkErrors{Errors: errors}
+}
+
+func UnmarshalRule(data []byte, typed map[string]interface{}) (string, interface{}, error) {
+	//	Decode the Key and Value of a rule. The Value of the keys in typed is decoded into the type of
+	//	the mapped example value, such as 0 or []string{}, when the API sends it in that shape. Other
+	//	values are decoded as plain JSON values.
+	var raw struct {
+		Key   string
+		Value json.RawMessage
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return "", nil, err
+	}
+	if raw.Value == nil {
+		return raw.Key, nil, nil
+	}
+	if example, ok := typed[raw.Key]; ok {
+		value := reflect.New(reflect.TypeOf(example))
+		if json.Unmarshal(raw.Value, value.Interface()) == nil {
+			return raw.Key, value.Elem().Interface(), nil
+		}
+	}
+	var value interface{}
+	err := json.Unmarshal(raw.Value, &value)
+	return raw.Key, value, err
+}
+
+func ToInt(value interface{}) (int, bool) {
+	//	Convert any integer value, including integral floats and json.Numbers, to an int
+	switch value := value.(type) {
+	case int:
+		return value, true
+	case int8:
+		return int(value), true
+	case int16:
+		return int(value), true
+	case int32:
+		return int(value), true
+	case int64:
+		return int(value), true
+	case uint:
+		return int(value), true
+	case uint8:
+		return int(value), true
+	case uint16:
+		return int(value), true
+	case uint32:
+		return int(value), true
+	case uint64:
+		return int(value), true
+	case float32:
+		return int(value), float32(int(value)) == value
+	case float64:
+		return int(value), float64(int(value)) == value
+	case json.Number:
+		number, err := value.Int64()
+		return int(number), err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	"reflect"
+)
+
+//	Lifecycle struct
+//
+//	Lifecycle describes the statuses of a resource and the changes allowed between them. It backs
+//	the Status type of each resource package.
+//
+//	Parameters:
+//	- Transitions [map of string to slice of strings]: statuses each status may change to. Statuses mapped to an empty slice are terminal. ex: map[string][]string{"processing": {"success", "failed"}, "success": {}}
+//	- Successful [slice of strings]: statuses in which the resource reached its intended outcome. ex: []string{"success"}
+
+type Lifecycle struct {
+	Transitions map[string][]string
+	Successful  []string
+}
+
+func (l Lifecycle) IsTerminal(status string) bool {
+	next, ok := l.Transitions[status]
+	return ok && len(next) == 0
+}
+
+func (l Lifecycle) IsSuccessful(status string) bool {
+	return contains(l.Successful, status)
+}
+
+func (l Lifecycle) CanTransitionTo(status string, next string) bool {
+	return contains(l.Transitions[status], next)
+}
+
+func CastParams(params map[string]interface{}) map[string]interface{} {
+	//	Convert typed strings, such as the Status of each resource, and Dates, such as the after and
+	//	before filters, to plain strings, as only those are sent by the query encoder. The informed
+	//	map is not changed.
+	if params == nil {
+		return nil
+	}
+	casted := make(map[string]interface{}, len(params))
+	for key, value := range params {
+		casted[key] = castParam(value)
+	}
+	return casted
+}
+
+func castParam(value interface{}) interface{} {
+	switch value := value.(type) {
+	case Date.Date:
+		return value.String()
+	case *Date.Date:
+		if value == nil {
+			return nil
+		}
+		return value.String()
+	}
+	reflected := reflect.ValueOf(value)
+	switch {
+	case value == nil:
+		return nil
+	case reflected.Kind() == reflect.String:
+		return reflected.String()
+	case reflected.Kind() == reflect.Slice && reflected.Type().Elem().Kind() == reflect.String:
+		strings := make([]string, reflected.Len())
+		for i := range strings {
+			strings[i] = reflected.Index(i).String()
+		}
+		return strings
+	}
+	return value
+}
+
+func contains(values []string, value string) bool {
+	for _, element := range values {
+		if element == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,438 @@
+package diagnostics
+
+import (
+	"encoding/json"
+	"fmt"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Attempt "github.com/starkbank/sdk-go/starkbank/event/attempt"
+	Webhook "github.com/starkbank/sdk-go/starkbank/webhook"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"sort"
+	"strings"
+	"time"
+)
+
+//	Webhook delivery diagnostics Report struct
+//
+//	A Report joins the failed delivery Attempts with their Events and Webhooks to help
+//	you find out why events are not reaching your endpoints.
+//
+//	Attributes:
+//	- Webhooks [slice of WebhookReport structs]: one report per Webhook, sorted by Url
+//	- MissingEventIds [slice of strings]: ids of attempted Events that could not be retrieved, such as deleted ones. ex: []string{"4848484848484848"}
+//	- Created [time.Time]: datetime when the report was generated
+
+type Report struct {
+	Webhooks        []WebhookReport `json:"webhooks"`
+	MissingEventIds []string        `json:"missingEventIds,omitempty"`
+	Created         time.Time       `json:"created"`
+}
+
+//	WebhookReport struct
+//
+//	Attributes:
+//	- WebhookId [string]: Webhook unique id. ex: "5656565656565656"
+//	- Url [string]: Webhook url. Empty if the Webhook was deleted. ex: "https://webhook.site/60e9c18e-4b5c-4369-bda1-ab5fcd8e1b29"
+//	- Subscriptions [slice of SubscriptionReport structs]: one report per subscription, sorted by name
+
+type WebhookReport struct {
+	WebhookId     string               `json:"webhookId"`
+	Url           string               `json:"url"`
+	Subscriptions []SubscriptionReport `json:"subscriptions"`
+}
+
+//	SubscriptionReport struct
+//
+//	Attributes:
+//	- Subscription [string]: Event subscription. ex: "transfer"
+//	- Events [int]: number of Events of this subscription considered in the report
+//	- FailedEvents [int]: number of those Events with at least one failed delivery Attempt to the Webhook
+//	- Attempts [int]: number of failed delivery Attempts to the Webhook
+//	- FailureRate [float64]: FailedEvents divided by Events, between 0 and 1. ex: 0.25
+//	- Codes [slice of Count structs]: Attempt codes, most common first. ex: "badHttpStatus", "timeout"
+//	- Messages [slice of Count structs]: Attempt messages, most common first. ex: "HTTP POST request returned status 404"
+//	- OldestUndelivered [Event struct pointer]: oldest Event of this subscription not delivered yet. nil if there is none
+//	- Timeline [slice of Retry structs]: failed Attempts of each Event in chronological order, oldest Event first
+
+type SubscriptionReport struct {
+	Subscription      string       `json:"subscription"`
+	Events            int          `json:"events"`
+	FailedEvents      int          `json:"failedEvents"`
+	Attempts          int          `json:"attempts"`
+	FailureRate       float64      `json:"failureRate"`
+	Codes             []Count      `json:"codes"`
+	Messages          []Count      `json:"messages"`
+	OldestUndelivered *Event.Event `json:"oldestUndelivered,omitempty"`
+	Timeline          []Retry      `json:"timeline"`
+}
+
+//	Count struct
+//
+//	Attributes:
+//	- Value [string]: counted Attempt code or message. ex: "badHttpStatus"
+//	- Count [int]: number of Attempts with this value. ex: 4
+
+type Count struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+//	Retry struct
+//
+//	Attributes:
+//	- EventId [string]: Id of the Event whose delivery failed. ex: "4848484848484848"
+//	- IsDelivered [bool]: True if the Event has been delivered since. ex: false
+//	- Attempts [slice of Attempt structs]: failed delivery Attempts of the Event in chronological order
+
+type Retry struct {
+	EventId     string            `json:"eventId"`
+	IsDelivered bool              `json:"isDelivered"`
+	Attempts    []Attempt.Attempt `json:"attempts"`
+}
+
+const (
+	defaultWindowDays = 7
+	maxEventIds       = 100
+)
+
+func Generate(params map[string]interface{}, user user.User) (Report, Error.StarkErrors) {
+	//	Generate a webhook delivery diagnostics Report
+	//
+	//	Retrieve the Webhooks, Events and failed delivery Attempts from the Stark Bank API and summarize them.
+	//	Attempted Events created before the date window are retrieved by id and the ones that cannot
+	//	be retrieved are listed in the Report MissingEventIds.
+	//
+	//	Parameters (optional):
+	//  - params [map[string]interface{}, default nil]: map of parameters for the Event and Attempt queries
+	//		- after [string or date.Date, default 7 days ago if before is nil]: Date filter for structs created only after specified date. ex: "2022-11-10"
+	//		- before [string or date.Date, default nil]: Date filter for structs created only before specified date. ex: "2022-11-10"
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
+	//
+	//	Return:
+	//	- Report struct
+	var webhooks []Webhook.Webhook
+	var events []Event.Event
+	var attempts []Attempt.Attempt
+	var errors []Error.StarkError
+
+	query := map[string]interface{}{}
+	for key, value := range params {
+		query[key] = value
+	}
+	if query["after"] == nil && query["before"] == nil {
+		query["after"] = Date.Today().AddDays(-defaultWindowDays)
+	}
+
+	webhookChannel, webhookErrors := Webhook.Query(nil, user)
+	webhookLoop:
+	for {
+		select {
+		case err := <-webhookErrors:
+			errors = append(errors, err.Errors...)
+		case webhook, ok := <-webhookChannel:
+			if !ok {
+				break webhookLoop
+			}
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	eventChannel, eventErrors := Event.Query(query, user)
+	eventLoop:
+	for {
+		select {
+		case err := <-eventErrors:
+			errors = append(errors, err.Errors...)
+		case event, ok := <-eventChannel:
+			if !ok {
+				break eventLoop
+			}
+			events = append(events, event)
+		}
+	}
+
+	attemptChannel, attemptErrors := Attempt.Query(query, user)
+	attemptLoop:
+	for {
+		select {
+		case err := <-attemptErrors:
+			errors = append(errors, err.Errors...)
+		case attempt, ok := <-attemptChannel:
+			if !ok {
+				break attemptLoop
+			}
+			attempts = append(attempts, attempt)
+		}
+	}
+	if errors != nil {
+		return Report{}, Error.StarkErrors{Errors: errors}
+	}
+
+	known := map[string]bool{}
+	for _, event := range events {
+		known[event.Id] = true
+	}
+	var missing []string
+	requested := map[string]bool{}
+	for _, attempt := range attempts {
+		if !known[attempt.EventId] && !requested[attempt.EventId] {
+			requested[attempt.EventId] = true
+			missing = append(missing, attempt.EventId)
+		}
+	}
+	retrieved := map[string]bool{}
+	for _, event := range fetchEvents(missing, user) {
+		if requested[event.Id] && !retrieved[event.Id] {
+			retrieved[event.Id] = true
+			events = append(events, event)
+		}
+	}
+
+	report := Summarize(webhooks, events, attempts)
+	for _, id := range missing {
+		if !retrieved[id] {
+			report.MissingEventIds = append(report.MissingEventIds, id)
+		}
+	}
+	return report, Error.StarkErrors{}
+}
+
+func fetchEvents(ids []string, user user.User) []Event.Event {
+	var events []Event.Event
+	for start := 0; start < len(ids); start += maxEventIds {
+		end := start + maxEventIds
+		if end > len(ids) {
+			end = len(ids)
+		}
+		eventChannel, errorChannel := Event.Query(map[string]interface{}{"ids": ids[start:end]}, user)
+		loop:
+		for {
+			select {
+			case <-errorChannel:
+			case event, ok := <-eventChannel:
+				if !ok {
+					break loop
+				}
+				events = append(events, event)
+			}
+		}
+	}
+	return events
+}
+
+func Summarize(webhooks []Webhook.Webhook, events []Event.Event, attempts []Attempt.Attempt) Report {
+	//	Summarize Webhooks, Events and Attempts into a Report
+	//
+	//	Use this function instead of Generate if you already retrieved the structs yourself.
+	//
+	//	Parameters (required):
+	//	- webhooks [slice of Webhook structs]: Webhooks registered in the Workspace
+	//	- events [slice of Event structs]: Events to be considered
+	//	- attempts [slice of Attempt structs]: failed delivery Attempts of those Events
+	//
+	//	Return:
+	//	- Report struct
+	eventsById := map[string]Event.Event{}
+	eventsBySubscription := map[string][]Event.Event{}
+	for _, event := range events {
+		eventsById[event.Id] = event
+		eventsBySubscription[event.Subscription] = append(eventsBySubscription[event.Subscription], event)
+	}
+
+	urls := map[string]string{}
+	subscriptions := map[string]map[string]bool{}
+	for _, webhook := range webhooks {
+		urls[webhook.Id] = webhook.Url
+		subscriptions[webhook.Id] = map[string]bool{}
+		for _, subscription := range webhook.Subscriptions {
+			subscriptions[webhook.Id][subscription] = true
+		}
+	}
+
+	grouped := map[string]map[string][]Attempt.Attempt{}
+	for _, attempt := range attempts {
+		subscription := eventsById[attempt.EventId].Subscription
+		if subscriptions[attempt.WebhookId] == nil {
+			subscriptions[attempt.WebhookId] = map[string]bool{}
+		}
+		subscriptions[attempt.WebhookId][subscription] = true
+		if grouped[attempt.WebhookId] == nil {
+			grouped[attempt.WebhookId] = map[string][]Attempt.Attempt{}
+		}
+		grouped[attempt.WebhookId][subscription] = append(grouped[attempt.WebhookId][subscription], attempt)
+	}
+
+	report := Report{Created: time.Now()}
+	for webhookId, names := range subscriptions {
+		webhookReport := WebhookReport{WebhookId: webhookId, Url: urls[webhookId]}
+		for subscription := range names {
+			webhookReport.Subscriptions = append(webhookReport.Subscriptions, summarizeSubscription(
+				subscription,
+				eventsBySubscription[subscription],
+				grouped[webhookId][subscription],
+				eventsById,
+			))
+		}
+		sort.Slice(webhookReport.Subscriptions, func(i, j int) bool {
+			return webhookReport.Subscriptions[i].Subscription < webhookReport.Subscriptions[j].Subscription
+		})
+		report.Webhooks = append(report.Webhooks, webhookReport)
+	}
+	sort.Slice(report.Webhooks, func(i, j int) bool {
+		if report.Webhooks[i].Url != report.Webhooks[j].Url {
+			return report.Webhooks[i].Url < report.Webhooks[j].Url
+		}
+		return report.Webhooks[i].WebhookId < report.Webhooks[j].WebhookId
+	})
+	return report
+}
+
+func (r Report) Json() (string, error) {
+	//	Dump the Report as an indented JSON string
+	response, err := json.MarshalIndent(r, "", "  ")
+	return string(response), err
+}
+
+func (r Report) Text() string {
+	//	Dump the Report as a human-readable text
+	var builder strings.Builder
+	for _, webhook := range r.Webhooks {
+		url := webhook.Url
+		if url == "" {
+			url = "(deleted webhook)"
+		}
+		fmt.Fprintf(&builder, "Webhook %s %s\n", webhook.WebhookId, url)
+		for _, subscription := range webhook.Subscriptions {
+			fmt.Fprintf(
+				&builder,
+				"  %s: %d events, %d failed (%.2f%%), %d failed attempts\n",
+				subscription.Subscription,
+				subscription.Events,
+				subscription.FailedEvents,
+				subscription.FailureRate*100,
+				subscription.Attempts,
+			)
+			if len(subscription.Codes) > 0 {
+				fmt.Fprintf(&builder, "    codes: %s\n", formatCounts(subscription.Codes))
+			}
+			if len(subscription.Messages) > 0 {
+				fmt.Fprintf(&builder, "    messages: %s\n", formatCounts(subscription.Messages))
+			}
+			if subscription.OldestUndelivered != nil {
+				fmt.Fprintf(&builder, "    oldest undelivered: %s", subscription.OldestUndelivered.Id)
+				if subscription.OldestUndelivered.Created != nil {
+					fmt.Fprintf(&builder, " created at %s", subscription.OldestUndelivered.Created.Format(time.RFC3339))
+				}
+				builder.WriteString("\n")
+			}
+			for _, retry := range subscription.Timeline {
+				var times []string
+				for _, attempt := range retry.Attempts {
+					if attempt.Created != nil {
+						times = append(times, attempt.Created.Format(time.RFC3339))
+					}
+				}
+				fmt.Fprintf(&builder, "    event %s (delivered: %t): %s\n", retry.EventId, retry.IsDelivered, strings.Join(times, ", "))
+			}
+		}
+	}
+	if len(r.MissingEventIds) > 0 {
+		fmt.Fprintf(&builder, "Events not found: %s\n", strings.Join(r.MissingEventIds, ", "))
+	}
+	return builder.String()
+}
+
+func summarizeSubscription(subscription string, events []Event.Event, attempts []Attempt.Attempt, eventsById map[string]Event.Event) SubscriptionReport {
+	report := SubscriptionReport{
+		Subscription: subscription,
+		Events:       len(events),
+		Attempts:     len(attempts),
+	}
+
+	for i := range events {
+		if events[i].IsDelivered || events[i].Created == nil {
+			continue
+		}
+		if report.OldestUndelivered == nil || events[i].Created.Before(*report.OldestUndelivered.Created) {
+			oldest := events[i]
+			report.OldestUndelivered = &oldest
+		}
+	}
+
+	codes := map[string]int{}
+	messages := map[string]int{}
+	retries := map[string]*Retry{}
+	for _, attempt := range attempts {
+		codes[attempt.Code]++
+		messages[attempt.Message]++
+		retry, ok := retries[attempt.EventId]
+		if !ok {
+			retry = &Retry{EventId: attempt.EventId, IsDelivered: eventsById[attempt.EventId].IsDelivered}
+			retries[attempt.EventId] = retry
+		}
+		retry.Attempts = append(retry.Attempts, attempt)
+	}
+	report.Codes = sortCounts(codes)
+	report.Messages = sortCounts(messages)
+
+	for _, retry := range retries {
+		sort.SliceStable(retry.Attempts, func(i, j int) bool {
+			return createdBefore(retry.Attempts[i].Created, retry.Attempts[j].Created)
+		})
+		report.Timeline = append(report.Timeline, *retry)
+	}
+	sort.Slice(report.Timeline, func(i, j int) bool {
+		a, b := eventCreated(report.Timeline[i], eventsById), eventCreated(report.Timeline[j], eventsById)
+		if createdBefore(a, b) || createdBefore(b, a) {
+			return createdBefore(a, b)
+		}
+		return report.Timeline[i].EventId < report.Timeline[j].EventId
+	})
+
+	report.FailedEvents = len(retries)
+	if report.Events < report.FailedEvents {
+		report.Events = report.FailedEvents
+	}
+	if report.Events > 0 {
+		report.FailureRate = float64(report.FailedEvents) / float64(report.Events)
+	}
+	return report
+}
+
+func sortCounts(counts map[string]int) []Count {
+	var sorted []Count
+	for value, count := range counts {
+		sorted = append(sorted, Count{Value: value, Count: count})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+	return sorted
+}
+
+func formatCounts(counts []Count) string {
+	var formatted []string
+	for _, count := range counts {
+		formatted = append(formatted, fmt.Sprintf("%q (%d)", count.Value, count.Count))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func eventCreated(retry Retry, eventsById map[string]Event.Event) *time.Time {
+	if event, ok := eventsById[retry.EventId]; ok && event.Created != nil {
+		return event.Created
+	}
+	return retry.Attempts[0].Created
+}
+
+func createdBefore(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b != nil
+	}
+	return a.Before(*b)
+}
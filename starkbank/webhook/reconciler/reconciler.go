@@ -0,0 +1,223 @@
+package reconciler
+
+import (
+	"fmt"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Webhook "github.com/starkbank/sdk-go/starkbank/webhook"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
+	"sort"
+	"strings"
+)
+
+//	Webhook reconciliation Plan struct
+//
+//	A Plan holds the changes needed to make the Webhooks registered in the Workspace match a
+//	desired configuration. Webhooks cannot be updated, so a Webhook whose subscriptions
+//	changed is deleted and created again.
+//
+//	Attributes:
+//	- Create [slice of Webhook structs]: desired Webhooks that are not registered yet
+//	- Delete [slice of Webhook structs]: registered Webhooks that are not desired or are outdated
+//	- Keep [slice of Webhook structs]: registered Webhooks that already match the desired configuration
+
+type Plan struct {
+	Create []Webhook.Webhook
+	Delete []Webhook.Webhook
+	Keep   []Webhook.Webhook
+}
+
+func Validate(desired []Webhook.Webhook) Error.StarkErrors {
+	//	Validate a desired Webhook configuration
+	//
+	//	Check that every Webhook has an url, that no url is repeated and that every subscription
+	//	is one of the event.Subscriptions parsed by the SDK.
+	//
+	//	Parameters (required):
+	//	- desired [slice of Webhook structs]: desired Webhook configuration
+	//
+	//	Return:
+	//	- Errors found in the configuration, if any
+	var errors []Error.StarkError
+	known := map[string]bool{}
+	for _, subscription := range Event.Subscriptions {
+		known[subscription] = true
+	}
+
+	urls := map[string]bool{}
+	for _, webhook := range desired {
+		if webhook.Url == "" {
+			errors = append(errors, Error.StarkError{Code: "invalidWebhookUrl", Message: "Webhook url must not be empty"})
+			continue
+		}
+		if urls[webhook.Url] {
+			errors = append(errors, Error.StarkError{Code: "duplicatedWebhookUrl", Message: fmt.Sprintf("Webhook url %s is repeated", webhook.Url)})
+		}
+		urls[webhook.Url] = true
+		if len(webhook.Subscriptions) == 0 {
+			errors = append(errors, Error.StarkError{Code: "invalidWebhookSubscriptions", Message: fmt.Sprintf("Webhook %s must have at least one subscription", webhook.Url)})
+		}
+		for _, subscription := range webhook.Subscriptions {
+			if !known[subscription] {
+				errors = append(errors, Error.StarkError{
+					Code:    "invalidWebhookSubscription",
+					Message: fmt.Sprintf("Webhook %s subscription %q is not one of %s", webhook.Url, subscription, strings.Join(Event.Subscriptions, ", ")),
+				})
+			}
+		}
+	}
+	return Error.StarkErrors{Errors: errors}
+}
+
+func Diff(desired []Webhook.Webhook, existing []Webhook.Webhook) Plan {
+	//	Compute the Plan between a desired configuration and the registered Webhooks
+	//
+	//	Webhooks are matched by url and their subscriptions are compared regardless of order.
+	//
+	//	Parameters (required):
+	//	- desired [slice of Webhook structs]: desired Webhook configuration
+	//	- existing [slice of Webhook structs]: Webhooks registered in the Workspace
+	//
+	//	Return:
+	//	- Plan struct
+	var plan Plan
+	wanted := map[string]Webhook.Webhook{}
+	for _, webhook := range desired {
+		wanted[webhook.Url] = webhook
+	}
+
+	matched := map[string]bool{}
+	for _, webhook := range existing {
+		target, ok := wanted[webhook.Url]
+		if !ok || matched[webhook.Url] || !sameSubscriptions(target.Subscriptions, webhook.Subscriptions) {
+			plan.Delete = append(plan.Delete, webhook)
+			continue
+		}
+		matched[webhook.Url] = true
+		plan.Keep = append(plan.Keep, webhook)
+	}
+
+	for _, webhook := range desired {
+		if !matched[webhook.Url] {
+			plan.Create = append(plan.Create, Webhook.Webhook{Url: webhook.Url, Subscriptions: webhook.Subscriptions})
+		}
+	}
+	return plan
+}
+
+func Prepare(desired []Webhook.Webhook, user user.User) (Plan, Error.StarkErrors) {
+	//	Plan the reconciliation of the Workspace Webhooks without changing them (dry run)
+	//
+	//	Parameters (required):
+	//	- desired [slice of Webhook structs]: desired Webhook configuration
+	//
+	//	Parameters (optional):
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
+	//
+	//	Return:
+	//	- Plan struct
+	err := Validate(desired)
+	if err.Errors != nil {
+		return Plan{}, err
+	}
+
+	var existing []Webhook.Webhook
+	var errors []Error.StarkError
+	webhooks, errorChannel := Webhook.Query(nil, user)
+	loop:
+	for {
+		select {
+		case err := <-errorChannel:
+			errors = append(errors, err.Errors...)
+		case webhook, ok := <-webhooks:
+			if !ok {
+				break loop
+			}
+			existing = append(existing, webhook)
+		}
+	}
+	if errors != nil {
+		return Plan{}, Error.StarkErrors{Errors: errors}
+	}
+	return Diff(desired, existing), Error.StarkErrors{}
+}
+
+func Apply(plan Plan, user user.User) (Plan, Error.StarkErrors) {
+	//	Apply a Plan to the Workspace Webhooks
+	//
+	//	Outdated Webhooks are deleted before the new ones are created, so that an url is never
+	//	registered twice. Events generated in between remain undelivered and can be recovered
+	//	with the event Poller.
+	//
+	//	Parameters (required):
+	//	- plan [Plan struct]: Plan returned by Prepare or Diff
+	//
+	//	Parameters (optional):
+	//	- user [Organization/Project struct, default nil]: Organization or Project struct. Not necessary if starkbank.User was set before function call
+	//
+	//	Return:
+	//	- Plan struct with the changes actually applied and the created Webhook ids
+	applied := Plan{Keep: plan.Keep}
+	for _, webhook := range plan.Delete {
+		deleted, err := Webhook.Delete(webhook.Id, user)
+		if err.Errors != nil {
+			return applied, err
+		}
+		applied.Delete = append(applied.Delete, deleted)
+	}
+	for _, webhook := range plan.Create {
+		created, err := Webhook.Create(webhook, user)
+		if err.Errors != nil {
+			return applied, err
+		}
+		applied.Create = append(applied.Create, created)
+	}
+	return applied, Error.StarkErrors{}
+}
+
+func (p Plan) IsEmpty() bool {
+	//	Return true if the Plan has no Webhook to create or delete
+	return len(p.Create) == 0 && len(p.Delete) == 0
+}
+
+func (p Plan) String() string {
+	//	Describe the Plan changes, one Webhook per line
+	var lines []string
+	for _, webhook := range p.Delete {
+		lines = append(lines, fmt.Sprintf("- delete %s %s [%s]", webhook.Id, webhook.Url, strings.Join(webhook.Subscriptions, ", ")))
+	}
+	for _, webhook := range p.Create {
+		lines = append(lines, fmt.Sprintf("+ create %s [%s]", webhook.Url, strings.Join(webhook.Subscriptions, ", ")))
+	}
+	for _, webhook := range p.Keep {
+		lines = append(lines, fmt.Sprintf("= keep %s %s [%s]", webhook.Id, webhook.Url, strings.Join(webhook.Subscriptions, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func sameSubscriptions(a []string, b []string) bool {
+	sortedA := unique(a)
+	sortedB := unique(b)
+	if len(sortedA) != len(sortedB) {
+		return false
+	}
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func unique(subscriptions []string) []string {
+	seen := map[string]bool{}
+	var sorted []string
+	for _, subscription := range subscriptions {
+		if !seen[subscription] {
+			seen[subscription] = true
+			sorted = append(sorted, subscription)
+		}
+	}
+	sort.Strings(sorted)
+	return sorted
+}
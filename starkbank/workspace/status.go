@@ -0,0 +1,44 @@
+package workspace
+
+import (
+	"github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+//	Workspace Status type
+//
+//	Workspaces are active while they can operate. They may be frozen or blocked and later
+//	reactivated, while closed Workspaces cannot be reopened.
+
+type Status string
+
+const (
+	Active  Status = "active"
+	Frozen  Status = "frozen"
+	Blocked Status = "blocked"
+	Closed  Status = "closed"
+)
+
+var lifecycle = utils.Lifecycle{
+	Successful: []string{"active"},
+	Transitions: map[string][]string{
+		"active":  {"frozen", "blocked", "closed"},
+		"frozen":  {"active", "blocked", "closed"},
+		"blocked": {"active", "closed"},
+		"closed":  {},
+	},
+}
+
+func (s Status) IsTerminal() bool {
+	//	Check if the status is final
+	return lifecycle.IsTerminal(string(s))
+}
+
+func (s Status) IsSuccessful() bool {
+	//	Check if the status is "active"
+	return lifecycle.IsSuccessful(string(s))
+}
+
+func (s Status) CanTransitionTo(status Status) bool {
+	//	Check if the status may change directly to the given one
+	return lifecycle.CanTransitionTo(string(s), string(status))
+}
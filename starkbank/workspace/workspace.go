@@ -25,7 +25,7 @@ import (
 //
 //	Attributes (return-only):
 //	- Id [string]: unique id returned when the workspace is created. ex: "5656565656565656"
-//	- Status [string]: current Workspace status. Options: "active", "closed", "frozen" or "blocked"
+//	- Status [Status]: current Workspace status. ex: "active", "frozen", "blocked" or "closed"
 //	- OrganizationId [string]: unique organization id returned when the organization is created.ex: "5656565656565656"
 //	- PictureUrl [string]: public workspace image (png) URL.ex: "https://storage.googleapis.com/api-ms-workspace-dev.appspot.com/pictures/workspace/5647143184367616.png?20230528223305"
 //	- Created [time.Time]: creation datetime for the payment. ex: time.Date(2020, 3, 10, 10, 30, 10, 0, time.UTC),
@@ -35,7 +35,7 @@ type Workspace struct {
 	Name           string     `json:",omitempty"`
 	AllowedTaxIds  []string   `json:",omitempty"`
 	Id             string     `json:",omitempty"`
-	Status         string     `json:",omitempty"`
+	Status         Status     `json:",omitempty"`
 	OrganizationId string     `json:",omitempty"`
 	PictureUrl     string     `json:",omitempty"`
 	Created        *time.Time `json:",omitempty"`
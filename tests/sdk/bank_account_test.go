@@ -0,0 +1,142 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	BankAccount "github.com/starkbank/sdk-go/starkbank/bankaccount"
+	DictKey "github.com/starkbank/sdk-go/starkbank/dictkey"
+	Institution "github.com/starkbank/sdk-go/starkbank/institution"
+	PixKey "github.com/starkbank/sdk-go/starkbank/pixkey"
+	Transfer "github.com/starkbank/sdk-go/starkbank/transfer"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBankAccountParse(t *testing.T) {
+
+	for _, c := range [][3]string{
+		{"001", "1584-9", "00210169-6"},
+		{"001", "1584", "210169-6"},
+		{"237", "1425-7", "0238069-2"},
+		{"341", "2545", "02366-1"},
+		{"033", "2006", "13000001-3"},
+		{"104", "0001", "00100000448-4"},
+		{"104", "0001", "1288000000448-0"},
+		{"260", "0001", "12345678-9"},
+	} {
+		err := BankAccount.Validate(c[0], c[1], c[2])
+		assert.Nil(t, err.Errors, "%v", c)
+	}
+
+	account, err := BankAccount.Parse("001", "1584 - 9", "210.169-6")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "1584", account.Branch)
+	assert.Equal(t, "9", account.BranchDigit)
+	assert.Equal(t, "00210169", account.Number)
+	assert.Equal(t, "1584-9", account.BranchCode())
+	assert.Equal(t, "00210169-6", account.AccountNumber())
+
+	account, err = BankAccount.Parse("341", "545", "2366-5")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "0545", account.BranchCode())
+
+	account, err = BankAccount.Parse("341", "2545", "2366-1")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "2545", account.BranchCode())
+	assert.Equal(t, "02366-1", account.AccountNumber())
+
+	assert.True(t, BankAccount.IsSupported("237"))
+	assert.False(t, BankAccount.IsSupported("260"))
+}
+
+func TestBankAccountParseInvalid(t *testing.T) {
+
+	for _, c := range [][3]string{
+		{"001", "1584-8", "00210169-6"},
+		{"001", "1584-9", "00210169-5"},
+		{"237", "1425-7", "0238069-3"},
+		{"341", "2545", "02366-2"},
+		{"341", "2545-1", "02366-1"},
+		{"341", "2545", "123456-1"},
+		{"033", "2006", "13000001-4"},
+		{"104", "0001", "00100000448-6"},
+		{"260", "0001", "12345678"},
+		{"260", "00001", "12345678-9"},
+		{"260", "0001", "1234a678-9"},
+	} {
+		err := BankAccount.Validate(c[0], c[1], c[2])
+		if assert.NotNil(t, err.Errors, "%v", c) {
+			assert.Equal(t, "invalidBankAccount", err.Errors[0].Code)
+		}
+	}
+}
+
+func TestBankAccountDirectoryResolve(t *testing.T) {
+
+	directory := BankAccount.Directory{Institutions: []Institution.Institution{
+		{DisplayName: "Stark Bank", SpiCode: "20018183", StrCode: "462"},
+		{DisplayName: "Itaú", SpiCode: "60701190", StrCode: "341"},
+		{DisplayName: "Banco do Brasil", SpiCode: "00000000", StrCode: "1"},
+		{DisplayName: "Pix only", SpiCode: "12345678"},
+	}}
+
+	route, err := directory.Resolve(Transfer.Transfer{BankCode: "60701190", BranchCode: "2545", AccountNumber: "02366-1"})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, BankAccount.Pix, route.Rail)
+	assert.Equal(t, "341", route.CompeCode)
+
+	route, err = directory.Resolve(Transfer.Transfer{BankCode: "001", BranchCode: "1584-9", AccountNumber: "00210169-6"})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, BankAccount.Ted, route.Rail)
+	assert.Equal(t, "Banco do Brasil", route.Institution.DisplayName)
+
+	route, err = directory.Resolve(Transfer.Transfer{BankCode: "12345678", BranchCode: "0001", AccountNumber: "1-9"})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, BankAccount.Pix, route.Rail)
+	assert.Equal(t, "", route.CompeCode)
+
+	_, err = directory.Resolve(Transfer.Transfer{BankCode: "00000000", BranchCode: "1584-9", AccountNumber: "00210169-5"})
+	assert.Equal(t, "invalidBankAccount", err.Errors[0].Code)
+
+	_, err = directory.Resolve(Transfer.Transfer{BankCode: "999", BranchCode: "0001", AccountNumber: "1-9"})
+	assert.Equal(t, "invalidBankCode", err.Errors[0].Code)
+
+	_, err = directory.Resolve(Transfer.Transfer{BankCode: "3410", BranchCode: "0001", AccountNumber: "1-9"})
+	assert.Equal(t, "invalidBankCode", err.Errors[0].Code)
+}
+
+func TestBankAccountDirectoryResolveDictKey(t *testing.T) {
+
+	directory := BankAccount.Directory{Institutions: []Institution.Institution{
+		{DisplayName: "Stark Bank", SpiCode: "20018183", StrCode: "462"},
+	}}
+
+	transfer := PixKey.ToTransfer(DictKey.DictKey{
+		Id:            "tony@starkbank.com",
+		Name:          "Tony Stark",
+		TaxId:         "***.345.678-**",
+		Ispb:          "20018183",
+		BranchCode:    "ZW5jcnlwdGVkLWJyYW5jaC1jb2Rl",
+		AccountNumber: "ZW5jcnlwdGVkLWFjY291bnQtbnVtYmVy",
+		AccountType:   "checking",
+	}, 1234)
+	route, err := directory.Resolve(transfer)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, BankAccount.Pix, route.Rail)
+	assert.Equal(t, "462", route.CompeCode)
+}
+
+func TestBankAccountDirectoryResolveSandbox(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	var directory BankAccount.Directory
+	route, err := directory.Resolve(Transfer.Transfer{BankCode: "20018183", BranchCode: "0001", AccountNumber: "10000-0"})
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	assert.Equal(t, BankAccount.Pix, route.Rail)
+	assert.Equal(t, "Stark Bank", route.Institution.DisplayName)
+}
@@ -0,0 +1,55 @@
+package sdk
+
+import (
+	Barcode "github.com/starkbank/sdk-go/starkbank/barcode"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBarcodeParseBillUtility(t *testing.T) {
+
+	bill, err := Barcode.ParseBill("84670000001-7 43590024020-9 02405000243-5 84221010811-9")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "84670000001435900240200240500024384221010811", bill.BarCode)
+	assert.Equal(t, "846700000017435900240209024050002435842210108119", bill.Line)
+	assert.Equal(t, "4", bill.Segment)
+	assert.Equal(t, Barcode.Utility, bill.Kind)
+	assert.Equal(t, "6", bill.ValueType)
+	assert.False(t, bill.IsReference)
+	assert.Equal(t, 14359, bill.Amount)
+	assert.Equal(t, "0024", bill.CompanyId)
+	assert.Equal(t, "0200240500024384221010811", bill.FreeField)
+}
+
+func TestBarcodeParseBillTax(t *testing.T) {
+
+	bill, err := Barcode.ParseBill("85890000460524601791606075930508683148300001")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "858900004609524601791605607593050865831483000010", bill.Line)
+	assert.Equal(t, "5", bill.Segment)
+	assert.Equal(t, Barcode.Tax, bill.Kind)
+	assert.Equal(t, "8", bill.ValueType)
+	assert.Equal(t, 4605246, bill.Amount)
+	assert.Equal(t, "0179", bill.CompanyId)
+
+	barCode, err := Barcode.BillBarCode(bill.Line)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, bill.BarCode, barCode)
+}
+
+func TestBarcodeParseBillTypos(t *testing.T) {
+
+	codes := []string{
+		"84670000001-7 43590024020-9 02405000243-5 84221010812-9",
+		"84670000001-7 43590024020-8 02405000243-5 84221010811-9",
+		"85890000460-9 52460179160-5 60759305086-5 83148300001-1",
+		"84670000001435900240200240500024384221010812",
+		"84570000001435900240200240500024384221010811",
+		"74670000001435900240200240500024384221010811",
+		"8467000000174359002402090240500024358422101081",
+	}
+	for _, code := range codes {
+		_, err := Barcode.ParseBill(code)
+		assert.Len(t, err.Errors, 1, code)
+	}
+}
@@ -0,0 +1,80 @@
+package sdk
+
+import (
+	Barcode "github.com/starkbank/sdk-go/starkbank/barcode"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBarcodeParseBoletoLine(t *testing.T) {
+
+	boleto, err := Barcode.ParseBoleto("34191.09008 76038.597308 71444.640008 4 92150000028000")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "34194921500000280001090076038597307144464000", boleto.BarCode)
+	assert.Equal(t, "34191090087603859730871444640008492150000028000", boleto.Line)
+	assert.Equal(t, "341", boleto.BankCode)
+	assert.Equal(t, "9", boleto.Currency)
+	assert.Equal(t, 9215, boleto.DueFactor)
+	assert.Equal(t, Date.New(2022, time.December, 30), *boleto.Due)
+	assert.Equal(t, 28000, boleto.Amount)
+	assert.Equal(t, "1090076038597307144464000", boleto.FreeField)
+}
+
+func TestBarcodeParseBoletoBarCode(t *testing.T) {
+
+	boleto, err := Barcode.ParseBoleto("34197819200000000011090063609567307144464000")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "34191090086360956730871444640008781920000000001", boleto.Line)
+	assert.Equal(t, 1, boleto.Amount)
+
+	line, err := Barcode.BoletoLine(boleto.BarCode)
+	assert.Nil(t, err.Errors)
+	barCode, err := Barcode.BoletoBarCode(line)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, boleto.BarCode, barCode)
+}
+
+func TestBarcodeParseBoletoTypos(t *testing.T) {
+
+	codes := []string{
+		"34191.09008 76038.597308 71444.640008 4 92150000028001",
+		"34191.09008 76038.597308 71444.640007 4 92150000028000",
+		"34191.09009 76038.597308 71444.640008 4 92150000028000",
+		"34194921500000280001090076038597307144464001",
+		"34191.09008 76038.597308 71444.640008 4 9215000002800",
+		"34191.09008 76038.597308 71444.64000A 4 92150000028000",
+	}
+	for _, code := range codes {
+		_, err := Barcode.ParseBoleto(code)
+		assert.Len(t, err.Errors, 1, code)
+	}
+}
+
+func TestBarcodeBoletoDueDate(t *testing.T) {
+
+	reference := time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, Date.New(2025, time.February, 22), *Barcode.DueDate(1000, reference))
+	assert.Equal(t, Date.New(2026, time.October, 19), *Barcode.DueDate(1604, reference))
+	assert.Equal(t, Date.New(2025, time.February, 21), *Barcode.DueDate(9999, time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, Date.New(2000, time.July, 3), *Barcode.DueDate(1000, time.Date(2000, 7, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Nil(t, Barcode.DueDate(0, reference))
+}
+
+func TestBarcodeBoletoRolloverFactor(t *testing.T) {
+
+	var barCode string
+	for digit := 0; digit < 10; digit++ {
+		candidate := "3419" + strconv.Itoa(digit) + "10000000015000" + "1090076038597307144464000"
+		if Barcode.ValidateBoletoBarCode(candidate).Errors == nil {
+			barCode = candidate
+		}
+	}
+	boleto, err := Barcode.ParseBoleto(barCode)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 1000, boleto.DueFactor)
+	assert.Equal(t, 15000, boleto.Amount)
+	assert.True(t, boleto.Due.After(Date.New(2025, time.February, 21)))
+}
@@ -2,6 +2,7 @@ package sdk
 
 import (
 	"github.com/starkbank/sdk-go/starkbank"
+	Brcode "github.com/starkbank/sdk-go/starkbank/brcode"
 	BrcodePayment "github.com/starkbank/sdk-go/starkbank/brcodepayment"
 	Utils "github.com/starkbank/sdk-go/tests/utils"
 	Example "github.com/starkbank/sdk-go/tests/utils/examples"
@@ -197,3 +198,30 @@ func TestBrcodePaymentUpdate(t *testing.T) {
 	}
 	assert.NotNil(t, updated)
 }
+
+func TestBrcodePaymentPostGeneratedBrcode(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	code, err := Brcode.Generate(Brcode.Brcode{
+		PixKey:       "tony@starkbank.com",
+		Amount:       100,
+		MerchantName: "Tony Stark",
+		MerchantCity: "Sao Paulo",
+	})
+	assert.Nil(t, err.Errors)
+
+	payments, err := BrcodePayment.Create([]BrcodePayment.BrcodePayment{{
+		Brcode:      code,
+		TaxId:       "012.345.678-90",
+		Description: "static brcode generated offline",
+	}}, nil)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	for _, payment := range payments {
+		assert.NotNil(t, payment.Id)
+	}
+}
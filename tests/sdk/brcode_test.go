@@ -0,0 +1,160 @@
+package sdk
+
+import (
+	Brcode "github.com/starkbank/sdk-go/starkbank/brcode"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestBrcodeDecodeStatic(t *testing.T) {
+
+	brcode, err := Brcode.Decode("00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D")
+	assert.Nil(t, err.Errors)
+	assert.False(t, brcode.IsDynamic())
+	assert.Equal(t, "01", brcode.PayloadFormat)
+	assert.Equal(t, "123e4567-e12b-12d1-a456-426655440000", brcode.PixKey)
+	assert.Equal(t, "0000", brcode.MerchantCategoryCode)
+	assert.Equal(t, "986", brcode.Currency)
+	assert.Equal(t, 0, brcode.Amount)
+	assert.Equal(t, "BR", brcode.Country)
+	assert.Equal(t, "Fulano de Tal", brcode.MerchantName)
+	assert.Equal(t, "BRASILIA", brcode.MerchantCity)
+	assert.Equal(t, "***", brcode.TxId)
+	assert.Equal(t, "1D3D", brcode.Crc)
+	assert.Nil(t, brcode.Recurrence)
+}
+
+func TestBrcodeDecodeAmountAndDescription(t *testing.T) {
+
+	payload := "000201010211" +
+		"26510014br.gov.bcb.pix0114+55119898989890211Monthly fee" +
+		"52040000530398654041.505802BR5915Stark Bank S.A.6009Sao Paulo610801414000" +
+		"62140510invoice123" +
+		"6304"
+	brcode, err := Brcode.Decode(payload + Brcode.Crc16(payload))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "11", brcode.InitiationMethod)
+	assert.Equal(t, "+5511989898989", brcode.PixKey)
+	assert.Equal(t, "Monthly fee", brcode.Description)
+	assert.Equal(t, 150, brcode.Amount)
+	assert.Equal(t, "01414000", brcode.PostalCode)
+	assert.Equal(t, "invoice123", brcode.TxId)
+}
+
+func TestBrcodeDecodeDynamic(t *testing.T) {
+
+	brcode, err := Brcode.Decode("00020101021226890014br.gov.bcb.pix2567invoice-h.sandbox.starkbank.com/v2/afdf94b770b0458a8440a335daf77c4c5204000053039865802BR5915Stark Bank S.A.6009Sao Paulo62070503***6304CC32")
+	assert.Nil(t, err.Errors)
+	assert.True(t, brcode.IsDynamic())
+	assert.Equal(t, "12", brcode.InitiationMethod)
+	assert.Equal(t, "invoice-h.sandbox.starkbank.com/v2/afdf94b770b0458a8440a335daf77c4c", brcode.Url)
+	assert.Equal(t, "", brcode.PixKey)
+}
+
+func TestBrcodeDecodeRecurrence(t *testing.T) {
+
+	brcode, err := Brcode.Decode("00020101021226180014br.gov.bcb.pix5204000053039865802BR5925Stark Sociedade de Credit6009Sao Paulo62070503***80930014br.gov.bcb.pix2571brcode-h.sandbox.starkinfra.com/v2/rec/d2766b29d5184e90853405a9720439a16304686F")
+	assert.Nil(t, err.Errors)
+	assert.NotNil(t, brcode.Recurrence)
+	assert.Equal(t, "brcode-h.sandbox.starkinfra.com/v2/rec/d2766b29d5184e90853405a9720439a1", brcode.Recurrence.Url)
+	assert.False(t, brcode.IsDynamic())
+}
+
+func TestBrcodeDecodeInvalid(t *testing.T) {
+
+	codes := []string{
+		"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3E",
+		"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***6304",
+		"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6099BRASILIA",
+		"0002012658",
+		"",
+	}
+	for _, code := range codes {
+		_, err := Brcode.Decode(code)
+		assert.NotNil(t, err.Errors, code)
+		assert.Equal(t, "invalidBrcode", err.Errors[0].Code)
+	}
+
+	payload := "00020126330014br.gov.bcb.pix0111012345678905204000053039865802BR6009Sao Paulo6304"
+	_, err := Brcode.Decode(payload + Brcode.Crc16(payload))
+	assert.Len(t, err.Errors, 1)
+}
+
+func TestBrcodeGenerateSpecExample(t *testing.T) {
+
+	code, err := Brcode.Generate(Brcode.Brcode{
+		PixKey:       "123e4567-e12b-12d1-a456-426655440000",
+		MerchantName: "Fulano de Tal",
+		MerchantCity: "BRASILIA",
+	})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D", code)
+}
+
+func TestBrcodeGenerateRoundTrip(t *testing.T) {
+
+	code, err := Brcode.Generate(Brcode.Brcode{
+		PixKey:           "tony@starkbank.com",
+		Amount:           123456,
+		MerchantName:     "João da Silva",
+		MerchantCity:     "São Paulo",
+		TxId:             "invoice123",
+		Description:      "Monthly fee",
+		InitiationMethod: "11",
+	})
+	assert.Nil(t, err.Errors)
+
+	brcode, err := Brcode.Decode(code)
+	assert.Nil(t, err.Errors)
+	assert.False(t, brcode.IsDynamic())
+	assert.Equal(t, "tony@starkbank.com", brcode.PixKey)
+	assert.Equal(t, 123456, brcode.Amount)
+	assert.Equal(t, "Joao da Silva", brcode.MerchantName)
+	assert.Equal(t, "Sao Paulo", brcode.MerchantCity)
+	assert.Equal(t, "invoice123", brcode.TxId)
+	assert.Equal(t, "Monthly fee", brcode.Description)
+	assert.Equal(t, "11", brcode.InitiationMethod)
+}
+
+func TestBrcodeGenerateAccentedDescription(t *testing.T) {
+
+	code, err := Brcode.Generate(Brcode.Brcode{
+		PixKey:       "tony@starkbank.com",
+		MerchantName: "Stark Bank S.A.",
+		MerchantCity: "São Paulo",
+		Description:  "Mensalidade de março",
+	})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, len([]rune(code)), len(code))
+
+	brcode, err := Brcode.Decode(code)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "Mensalidade de marco", brcode.Description)
+
+	_, err = Brcode.Generate(Brcode.Brcode{
+		PixKey:       "tony@starkbank.com",
+		MerchantName: "Stark Bank S.A.",
+		MerchantCity: "Sao Paulo",
+		Description:  "Café ☕",
+	})
+	assert.Len(t, err.Errors, 1)
+}
+
+func TestBrcodeGenerateInvalid(t *testing.T) {
+
+	_, err := Brcode.Generate(Brcode.Brcode{
+		MerchantName: "A merchant name longer than allowed",
+		MerchantCity: "Sao Paulo",
+		TxId:         "invoice-123",
+		Amount:       -1,
+	})
+	assert.Len(t, err.Errors, 4)
+
+	_, err = Brcode.Generate(Brcode.Brcode{
+		PixKey:       "tony@starkbank.com",
+		Url:          "api.starkbank.com/v2/brcode/5656565656565656",
+		MerchantName: "Stark Bank S.A.",
+		MerchantCity: "Sao Paulo",
+	})
+	assert.Len(t, err.Errors, 1)
+}
@@ -0,0 +1,134 @@
+package sdk
+
+import (
+	Calendar "github.com/starkbank/sdk-go/starkbank/calendar"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCalendarEaster(t *testing.T) {
+
+	for year, expected := range map[int]string{
+		2000: "2000-04-23",
+		2019: "2019-04-21",
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2026: "2026-04-05",
+		2038: "2038-04-25",
+	} {
+		assert.Equal(t, expected, Calendar.Easter(year, time.UTC).Format("2006-01-02"))
+	}
+}
+
+func TestCalendarHolidays(t *testing.T) {
+
+	var calendar Calendar.Calendar
+	var dates []string
+	for _, holiday := range calendar.Holidays(2025) {
+		dates = append(dates, holiday.Date.Format("2006-01-02"))
+		assert.False(t, holiday.Local)
+	}
+	assert.Equal(t, []string{
+		"2025-01-01", "2025-03-03", "2025-03-04", "2025-04-18", "2025-04-21", "2025-05-01",
+		"2025-06-19", "2025-09-07", "2025-10-12", "2025-11-02", "2025-11-15", "2025-11-20", "2025-12-25",
+	}, dates)
+
+	assert.Len(t, calendar.Holidays(2023), 12)
+
+	holiday, ok := calendar.Holiday(time.Date(2024, 5, 30, 12, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, "Corpus Christi", holiday.Name)
+
+	_, ok = calendar.Holiday(time.Date(2024, 2, 14, 12, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestCalendarLocalHolidays(t *testing.T) {
+
+	var calendar Calendar.Calendar
+	calendar.AddYearlyHoliday(time.January, 25, "Aniversário de São Paulo")
+	calendar.AddHoliday(time.Date(2024, 7, 9, 0, 0, 0, 0, time.UTC), "Revolução Constitucionalista")
+
+	assert.False(t, calendar.IsBusinessDay(time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, calendar.IsBusinessDay(time.Date(2027, 1, 25, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, calendar.IsBusinessDay(time.Date(2024, 7, 9, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, calendar.IsBusinessDay(time.Date(2025, 7, 9, 0, 0, 0, 0, time.UTC)))
+
+	holiday, ok := calendar.Holiday(time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.True(t, holiday.Local)
+	assert.Equal(t, "Aniversário de São Paulo", holiday.Name)
+
+	holidays := calendar.Holidays(2024)
+	assert.Len(t, holidays, 15)
+	assert.Equal(t, "2024-01-01", holidays[0].Date.Format("2006-01-02"))
+	assert.Equal(t, "2024-01-25", holidays[1].Date.Format("2006-01-02"))
+}
+
+func TestCalendarBusinessDays(t *testing.T) {
+
+	var calendar Calendar.Calendar
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 12, 0, 0, 0, time.UTC)
+	}
+	format := func(date time.Time) string {
+		return date.Format("2006-01-02")
+	}
+
+	assert.False(t, calendar.IsBusinessDay(date(2024, 2, 10)))
+	assert.False(t, calendar.IsBusinessDay(date(2024, 2, 12)))
+	assert.True(t, calendar.IsBusinessDay(date(2024, 2, 14)))
+
+	assert.Equal(t, "2024-02-14", format(calendar.NextBusinessDay(date(2024, 2, 9))))
+	assert.Equal(t, "2024-02-09", format(calendar.PreviousBusinessDay(date(2024, 2, 14))))
+	assert.Equal(t, "2024-12-26", format(calendar.NextBusinessDay(date(2024, 12, 24))))
+	assert.Equal(t, "2024-12-30", format(calendar.AddBusinessDays(date(2024, 12, 24), 3)))
+	assert.Equal(t, "2024-12-20", format(calendar.AddBusinessDays(date(2024, 12, 26), -3)))
+	assert.Equal(t, "2024-12-25", format(calendar.AddBusinessDays(date(2024, 12, 25), 0)))
+	assert.Equal(t, "2024-12-26", format(calendar.Adjust(date(2024, 12, 25))))
+	assert.Equal(t, "2024-12-24", format(calendar.Adjust(date(2024, 12, 24))))
+
+	assert.Equal(t, 5, calendar.BusinessDaysBetween(date(2024, 12, 24), date(2025, 1, 2)))
+	assert.Equal(t, -5, calendar.BusinessDaysBetween(date(2025, 1, 2), date(2024, 12, 24)))
+
+	assert.Equal(t, "2024-12-30", format(calendar.AddBusinessDays(time.Date(2024, 12, 31, 1, 0, 0, 0, time.UTC), 0)))
+	assert.Equal(t, "2024-12-31", format(calendar.AddBusinessDays(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), 0)))
+}
+
+func TestCalendarUtcMidnight(t *testing.T) {
+
+	var calendar Calendar.Calendar
+	format := func(date time.Time) string {
+		return date.Format("2006-01-02")
+	}
+
+	assert.True(t, calendar.IsBusinessDay(time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2024-02-14", format(calendar.Day(time.Date(2024, 2, 14, 0, 0, 0, 0, time.UTC))))
+	assert.Equal(t, "2024-02-14", format(calendar.NextBusinessDay(time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC))))
+	assert.True(t, calendar.IsBusinessDay(Date.New(2024, time.February, 14).Time()))
+	assert.False(t, calendar.IsBusinessDay(time.Date(2024, 2, 14, 0, 0, 0, 0, time.FixedZone("+09", 9*60*60))))
+}
+
+func TestCalendarSettlementDate(t *testing.T) {
+
+	calendar := Calendar.Calendar{Location: time.FixedZone("-03", -3*60*60)}
+	at := func(day int, hour int, minute int) time.Time {
+		return time.Date(2024, 2, day, hour, minute, 0, 0, calendar.Location)
+	}
+	format := func(date time.Time) string {
+		return date.Format("2006-01-02")
+	}
+
+	assert.Equal(t, "2024-02-08", format(calendar.SettlementDate(Calendar.Ted, at(8, 15, 59))))
+	assert.Equal(t, "2024-02-09", format(calendar.SettlementDate(Calendar.Ted, at(8, 16, 0))))
+	assert.Equal(t, "2024-02-14", format(calendar.SettlementDate(Calendar.Ted, at(9, 17, 0))))
+	assert.Equal(t, "2024-02-14", format(calendar.SettlementDate(Calendar.Ted, at(12, 10, 0))))
+	assert.Equal(t, "2024-02-12", format(calendar.SettlementDate(Calendar.Pix, at(12, 10, 0))))
+	assert.Equal(t, "2024-02-09", format(calendar.SettlementDate(Calendar.Pix, at(9, 23, 59))))
+
+	calendar.TedCutoff = 17 * time.Hour
+	assert.Equal(t, "2024-02-08", format(calendar.SettlementDate(Calendar.Ted, at(8, 16, 30))))
+	assert.Equal(t, "2024-02-09", format(calendar.SettlementDate(Calendar.Ted, time.Date(2024, 2, 8, 20, 30, 0, 0, time.UTC))))
+}
@@ -0,0 +1,191 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	Boleto "github.com/starkbank/sdk-go/starkbank/boleto"
+	Charge "github.com/starkbank/sdk-go/starkbank/charge"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	Invoice "github.com/starkbank/sdk-go/starkbank/invoice"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestChargeCalculate(t *testing.T) {
+
+	var calculator Charge.Calculator
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	terms := Charge.Terms{
+		NominalAmount: 400000,
+		Due:           date(time.March, 8),
+		Fine:          2.0,
+		Interest:      1.0,
+		Discounts: []Charge.Discount{
+			{Percentage: 2.0, Due: date(time.March, 6)},
+			{Percentage: 5.0, Due: date(time.March, 1)},
+		},
+	}
+
+	for day, expected := range map[int][5]int{
+		0:  {380000, 0, 0, 20000, 0},
+		1:  {380000, 0, 0, 20000, 0},
+		4:  {392000, 0, 0, 8000, 0},
+		6:  {392000, 0, 0, 8000, 0},
+		8:  {400000, 0, 0, 0, 0},
+		11: {408400, 8000, 400, 0, 3},
+		25: {410267, 8000, 2267, 0, 17},
+	} {
+		charge, err := calculator.Calculate(terms, date(time.March, day))
+		assert.Nil(t, err.Errors)
+		assert.Equal(t, expected, [5]int{charge.Amount, charge.FineAmount, charge.InterestAmount, charge.DiscountAmount, charge.DaysLate}, "March %d", day)
+		assert.Equal(t, 400000, charge.NominalAmount)
+	}
+
+	terms.Fine = -1
+	_, err := calculator.Calculate(terms, date(time.March, 11))
+	assert.Equal(t, "invalidCharge", err.Errors[0].Code)
+}
+
+func TestChargeCalculateBusinessDays(t *testing.T) {
+
+	var calculator Charge.Calculator
+	terms := Charge.Terms{
+		NominalAmount: 10000,
+		Due:           time.Date(2024, 2, 12, 0, 0, 0, 0, time.UTC),
+		Fine:          2.0,
+		Interest:      3.0,
+		Discounts:     []Charge.Discount{{Percentage: 10, Due: time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	charge, err := calculator.Calculate(terms, time.Date(2024, 2, 14, 15, 0, 0, 0, time.UTC))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "2024-02-14", charge.Due.Format("2006-01-02"))
+	assert.Equal(t, 10000, charge.Amount)
+
+	charge, err = calculator.Calculate(terms, time.Date(2024, 2, 9, 15, 0, 0, 0, time.UTC))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 9000, charge.Amount)
+
+	charge, err = calculator.Calculate(terms, time.Date(2024, 2, 15, 15, 0, 0, 0, time.UTC))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 3, charge.DaysLate)
+	assert.Equal(t, 10000+200+30, charge.Amount)
+
+	charge, err = calculator.Calculate(terms, time.Date(2024, 2, 15, 2, 0, 0, 0, time.UTC))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 10000, charge.Amount)
+}
+
+func TestChargeInvoiceAndBoleto(t *testing.T) {
+
+	var calculator Charge.Calculator
+	due := time.Date(2024, 3, 9, 2, 59, 59, 999999000, time.UTC)
+	invoiceDiscount := time.Date(2024, 3, 6, 2, 59, 59, 999999000, time.UTC)
+
+	charge, err := calculator.Invoice(Invoice.Invoice{
+		Amount:        390000,
+		NominalAmount: 400000,
+		Due:           &due,
+		Fine:          2.5,
+		Interest:      1.3,
+		Discounts:     []Invoice.Discount{{Percentage: 2.5, Due: &invoiceDiscount}},
+	}, time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 390000, charge.Amount)
+	assert.Equal(t, "2024-03-08", charge.Due.Format("2006-01-02"))
+
+	charge, err = calculator.Invoice(Invoice.Invoice{Amount: 400000, Due: &due, Fine: 2.5, Interest: 1.3}, time.Date(2024, 3, 13, 12, 0, 0, 0, time.UTC))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 5, charge.DaysLate)
+	assert.Equal(t, 10000, charge.FineAmount)
+	assert.Equal(t, 867, charge.InterestAmount)
+
+	_, err = calculator.Invoice(Invoice.Invoice{Amount: 400000}, time.Now())
+	assert.Equal(t, "invalidCharge", err.Errors[0].Code)
+
+	boletoDue := Date.New(2024, time.March, 8)
+	discountDate := Date.New(2024, time.March, 1)
+	charge, err = calculator.Boleto(Boleto.Boleto{
+		Amount:    20000,
+		Due:       &boletoDue,
+		Discounts: []Boleto.Discount{{Percentage: 1, Date: &discountDate}},
+	}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 19800, charge.Amount)
+
+	_, err = calculator.Boleto(Boleto.Boleto{
+		Amount:    20000,
+		Due:       &boletoDue,
+		Discounts: []Boleto.Discount{{Percentage: 1}},
+	}, time.Now())
+	assert.Equal(t, "invalidCharge", err.Errors[0].Code)
+}
+
+func TestChargeInvoiceSandbox(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	now := time.Now()
+	due := time.Date(now.Year(), now.Month(), now.Day()+10, 0, 0, 0, 0, time.UTC)
+	discount := time.Date(now.Year(), now.Month(), now.Day()+3, 0, 0, 0, 0, time.UTC)
+	invoices, err := Invoice.Create([]Invoice.Invoice{{
+		Amount:    123456,
+		Name:      "Tony Stark",
+		TaxId:     "38.446.231/0001-04",
+		Due:       &due,
+		Fine:      2.5,
+		Interest:  1.3,
+		Discounts: []Invoice.Discount{{Percentage: 3.5, Due: &discount}},
+	}}, nil)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+		return
+	}
+
+	invoice, err := Invoice.Get(invoices[0].Id, nil)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+
+	charge, err := Charge.Calculator{}.Invoice(invoice, time.Now())
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, invoice.NominalAmount, charge.NominalAmount)
+	assert.Equal(t, invoice.DiscountAmount, charge.DiscountAmount)
+	assert.Equal(t, invoice.FineAmount, charge.FineAmount)
+	assert.Equal(t, invoice.InterestAmount, charge.InterestAmount)
+	assert.Equal(t, invoice.Amount, charge.Amount)
+
+	var overdueList []Invoice.Invoice
+	overdue, errorChannel := Invoice.Query(map[string]interface{}{"status": "overdue", "limit": 1}, nil)
+	loop:
+	for {
+		select {
+		case err := <-errorChannel:
+			if err.Errors != nil {
+				for _, e := range err.Errors {
+					t.Errorf("code: %s, message: %s", e.Code, e.Message)
+				}
+			}
+		case invoice, ok := <-overdue:
+			if !ok {
+				break loop
+			}
+			overdueList = append(overdueList, invoice)
+		}
+	}
+
+	for _, invoice := range overdueList {
+		charge, err := Charge.Calculator{}.Invoice(invoice, time.Now())
+		assert.Nil(t, err.Errors)
+		assert.True(t, charge.DaysLate > 0)
+		assert.Equal(t, invoice.FineAmount, charge.FineAmount)
+		assert.Equal(t, invoice.InterestAmount, charge.InterestAmount)
+	}
+}
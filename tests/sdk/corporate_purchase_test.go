@@ -146,3 +146,19 @@ func TestCorporatePurchaseResponseDenied(t *testing.T) {
 	response := CorporatePurchase.Response(denied)
 	assert.NotNil(t, response)
 }
+
+func TestCorporatePurchaseLocalKeyParse(t *testing.T) {
+
+	starkbank.User = utils.ExampleProject
+	defer utils.UseLocalKey()()
+
+	content := "{\"acquirerId\": \"236090\", \"amount\": 100, \"cardId\": \"5671893688385536\", \"holderId\": \"5917814565109760\", \"merchantName\": \"COMPANY 123\", \"methodCode\": \"token\"}"
+
+	parsed, err := CorporatePurchase.Parse(content, utils.Sign(content), nil)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 100, parsed.Amount)
+	assert.Equal(t, "COMPANY 123", parsed.MerchantName)
+
+	_, err = CorporatePurchase.Parse(content, utils.Sign("{}"), nil)
+	assert.NotNil(t, err.Errors)
+}
@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"encoding/json"
+	BoletoPayment "github.com/starkbank/sdk-go/starkbank/boletopayment"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	DarfPayment "github.com/starkbank/sdk-go/starkbank/darfpayment"
+	"github.com/starkbank/sdk-go/starkbank/utils"
+	"github.com/starkinfra/core-go/starkcore/utils/api"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDateConversions(t *testing.T) {
+
+	date := Date.New(2024, time.March, 10)
+	assert.Equal(t, Date.Date{Year: 2024, Month: time.March, Day: 10}, date)
+	assert.Equal(t, "2024-03-10", date.String())
+	assert.Equal(t, Date.New(2024, time.April, 1), Date.New(2024, time.March, 32))
+	assert.Equal(t, Date.New(2024, time.February, 29), date.AddDays(-10))
+
+	assert.Equal(t, Date.New(2024, time.March, 9), Date.Of(time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, Date.New(2024, time.March, 9), Date.Of(time.Date(2024, 3, 10, 2, 59, 59, 999999000, time.UTC)))
+	assert.Equal(t, date, Date.Of(time.Date(2024, 3, 10, 3, 0, 0, 0, time.UTC)))
+	assert.Equal(t, date, Date.Of(date.Time()))
+	assert.Equal(t, time.Date(2024, 3, 10, 3, 0, 0, 0, time.UTC), date.Time().UTC())
+
+	assert.True(t, date.Before(Date.New(2024, time.March, 11)))
+	assert.True(t, date.After(Date.New(2023, time.December, 31)))
+	assert.False(t, date.Before(date))
+	assert.True(t, Date.Date{}.IsZero())
+	assert.False(t, Date.Today().IsZero())
+}
+
+func TestDateParse(t *testing.T) {
+
+	date, err := Date.Parse("2024-03-10")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, Date.New(2024, time.March, 10), date)
+
+	date, err = Date.Parse("2024-03-10T02:59:59.999999+00:00")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, Date.New(2024, time.March, 9), date)
+
+	for _, value := range []string{"", "10/03/2024", "2024-02-30", "2024-3-10"} {
+		_, err = Date.Parse(value)
+		assert.Equal(t, "invalidDate", err.Errors[0].Code, value)
+	}
+}
+
+func TestDateJson(t *testing.T) {
+
+	scheduled := Date.New(2024, time.March, 10)
+	payload := api.ApiJson(BoletoPayment.BoletoPayment{Line: "34191.09008 63571.277308 71444.640008 5 81960000000062", Scheduled: &scheduled}, map[string]string{"name": "BoletoPayment"}).(map[string]interface{})
+	assert.Equal(t, "2024-03-10", payload["scheduled"])
+
+	payload = api.ApiJson(struct{ Due Date.Date }{}, map[string]string{"name": "Test"}).(map[string]interface{})
+	_, ok := payload["due"]
+	assert.False(t, ok)
+
+	var payment DarfPayment.DarfPayment
+	assert.Nil(t, json.Unmarshal([]byte(`{"competence": "2024-02-01", "due": "2024-03-10T02:59:59.999999+00:00", "scheduled": null}`), &payment))
+	assert.Equal(t, Date.New(2024, time.February, 1), *payment.Competence)
+	assert.Equal(t, Date.New(2024, time.March, 9), *payment.Due)
+	assert.Nil(t, payment.Scheduled)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"due": "tomorrow"}`), &payment))
+}
+
+func TestDateQueryParams(t *testing.T) {
+
+	after := Date.New(2024, time.March, 1)
+	params := utils.CastParams(map[string]interface{}{"after": after, "before": &after, "limit": 10})
+	assert.Equal(t, map[string]interface{}{"after": "2024-03-01", "before": "2024-03-01", "limit": 10}, params)
+}
@@ -0,0 +1,112 @@
+package sdk
+
+import (
+	"encoding/json"
+	Boleto "github.com/starkbank/sdk-go/starkbank/boleto"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	Invoice "github.com/starkbank/sdk-go/starkbank/invoice"
+	"github.com/starkinfra/core-go/starkcore/utils/api"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDiscountInvoiceWireFormat(t *testing.T) {
+
+	due := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	discount := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	payload := api.ApiJson(Invoice.Invoice{
+		Amount:       1000,
+		Due:          &due,
+		Discounts:    []Invoice.Discount{{Percentage: 2.5, Due: &discount}},
+		Descriptions: []Invoice.Description{{Key: "Product", Value: "Iron Suit"}, {Key: "Delivery"}},
+	}, map[string]string{"name": "Invoice"}).(map[string]interface{})
+
+	assert.Equal(t, []interface{}{map[string]interface{}{"percentage": 2.5, "due": "2024-03-05"}}, payload["discounts"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "Product", "value": "Iron Suit"},
+		map[string]interface{}{"key": "Delivery"},
+	}, payload["descriptions"])
+
+	var invoice Invoice.Invoice
+	err := json.Unmarshal([]byte(`{
+		"discounts": [{"percentage": 5, "due": "2024-03-05T02:59:59.999999+00:00"}, {"percentage": 2, "due": "2024-03-07"}],
+		"descriptions": [{"key": "Product", "value": "Iron Suit"}]
+	}`), &invoice)
+	assert.Nil(t, err)
+	assert.Equal(t, 5.0, invoice.Discounts[0].Percentage)
+	assert.Equal(t, time.Date(2024, 3, 5, 2, 59, 59, 999999000, time.UTC), invoice.Discounts[0].Due.UTC())
+	assert.Equal(t, "2024-03-07", invoice.Discounts[1].Due.Format("2006-01-02"))
+	assert.Equal(t, []Invoice.Description{{Key: "Product", Value: "Iron Suit"}}, invoice.Descriptions)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"discounts": [{"percentage": 5, "due": "next week"}]}`), &invoice))
+}
+
+func TestDiscountInvoiceValidation(t *testing.T) {
+
+	due := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	_, err := Invoice.Create([]Invoice.Invoice{{
+		Amount: 1000,
+		Name:   "Tony Stark",
+		TaxId:  "012.345.678-90",
+		Due:    &due,
+		Discounts: []Invoice.Discount{
+			{Percentage: 0, Due: &before},
+			{Percentage: 101, Due: &before},
+			{Percentage: 5, Due: &after},
+			{Percentage: 5, Due: &due},
+			{Percentage: 5},
+		},
+		Descriptions: []Invoice.Description{{Value: "Iron Suit"}},
+	}}, nil)
+	assert.Equal(t, 6, len(err.Errors))
+	for _, e := range err.Errors[:5] {
+		assert.Equal(t, "invalidDiscount", e.Code)
+	}
+	assert.Equal(t, "invalidDescription", err.Errors[5].Code)
+
+	discounts := make([]Invoice.Discount, Invoice.MaxDiscounts+1)
+	for i := range discounts {
+		discounts[i] = Invoice.Discount{Percentage: 1, Due: &before}
+	}
+	_, err = Invoice.Create([]Invoice.Invoice{{Amount: 1000, Due: &due, Discounts: discounts}}, nil)
+	assert.Equal(t, 1, len(err.Errors))
+	assert.Equal(t, "invalidDiscount", err.Errors[0].Code)
+}
+
+func TestDiscountBoleto(t *testing.T) {
+
+	due := Date.New(2024, time.March, 9)
+	discount := Date.New(2024, time.March, 5)
+	payload := api.ApiJson(Boleto.Boleto{
+		Amount:       1000,
+		Due:          &due,
+		Discounts:    []Boleto.Discount{{Percentage: 1, Date: &discount}},
+		Descriptions: []Boleto.Description{{Text: "Iron Suit", Amount: 800}, {Text: "Delivery"}},
+	}, map[string]string{"name": "Boleto"}).(map[string]interface{})
+
+	assert.Equal(t, []interface{}{map[string]interface{}{"percentage": 1.0, "date": "2024-03-05"}}, payload["discounts"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"text": "Iron Suit", "amount": 800.0},
+		map[string]interface{}{"text": "Delivery"},
+	}, payload["descriptions"])
+
+	var boleto Boleto.Boleto
+	assert.Nil(t, json.Unmarshal([]byte(`{"discounts": [{"percentage": 1, "date": "2024-03-05"}], "descriptions": [{"text": "Iron Suit", "amount": 800}]}`), &boleto))
+	assert.Equal(t, Date.New(2024, time.March, 5), *boleto.Discounts[0].Date)
+	assert.Equal(t, []Boleto.Description{{Text: "Iron Suit", Amount: 800}}, boleto.Descriptions)
+
+	sameDay := Date.Of(time.Date(2024, 3, 9, 12, 0, 0, 0, time.UTC))
+	_, err := Boleto.Create([]Boleto.Boleto{{
+		Amount:       1000,
+		Due:          &due,
+		Discounts:    []Boleto.Discount{{Percentage: 1, Date: &sameDay}, {Percentage: -1, Date: &discount}},
+		Descriptions: []Boleto.Description{{Amount: 100}, {Text: "Refund", Amount: -100}},
+	}}, nil)
+	assert.Equal(t, 4, len(err.Errors))
+	assert.Equal(t, "invalidDiscount", err.Errors[0].Code)
+	assert.Equal(t, "invalidDescription", err.Errors[3].Code)
+}
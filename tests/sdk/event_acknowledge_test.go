@@ -0,0 +1,97 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func undeliveredEventIds(t *testing.T, limit int) []string {
+	var params = map[string]interface{}{}
+	params["limit"] = limit
+	params["isDelivered"] = false
+
+	var ids []string
+	events, errorChannel := Event.Query(params, nil)
+	loop:
+	for {
+		select {
+		case err := <-errorChannel:
+			if err.Errors != nil {
+				for _, e := range err.Errors {
+					t.Errorf("code: %s, message: %s", e.Code, e.Message)
+				}
+			}
+		case event, ok := <-events:
+			if !ok {
+				break loop
+			}
+			ids = append(ids, event.Id)
+		}
+	}
+	return ids
+}
+
+func TestEventAcknowledge(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	ids := undeliveredEventIds(t, 10)
+
+	var params = map[string]interface{}{}
+	params["concurrency"] = 3
+	params["requestsPerSecond"] = 5
+
+	acknowledgments := Event.Acknowledge(ids, params, nil)
+	assert.Equal(t, len(ids), len(acknowledgments))
+	for i, acknowledgment := range acknowledgments {
+		for _, e := range acknowledgment.Errors.Errors {
+			t.Errorf("id: %s, code: %s, message: %s", acknowledgment.Id, e.Code, e.Message)
+		}
+		assert.Equal(t, ids[i], acknowledgment.Id)
+		assert.Equal(t, ids[i], acknowledgment.Event.Id)
+		assert.True(t, acknowledgment.Event.IsDelivered)
+		assert.GreaterOrEqual(t, acknowledgment.Attempts, 1)
+	}
+}
+
+func TestEventAcknowledgeStream(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	ids := undeliveredEventIds(t, 5)
+
+	idChannel := make(chan string)
+	go func() {
+		for _, id := range ids {
+			idChannel <- id
+		}
+		close(idChannel)
+	}()
+
+	acknowledged := map[string]bool{}
+	for acknowledgment := range Event.AcknowledgeStream(idChannel, nil, nil) {
+		for _, e := range acknowledgment.Errors.Errors {
+			t.Errorf("id: %s, code: %s, message: %s", acknowledgment.Id, e.Code, e.Message)
+		}
+		assert.True(t, acknowledgment.Event.IsDelivered)
+		acknowledged[acknowledgment.Id] = true
+	}
+	assert.Equal(t, len(ids), len(acknowledged))
+}
+
+func TestEventAcknowledgeInvalidId(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	var params = map[string]interface{}{}
+	params["retries"] = 1
+
+	acknowledgments := Event.Acknowledge([]string{"0"}, params, nil)
+	assert.Equal(t, 1, len(acknowledgments))
+	assert.NotNil(t, acknowledgments[0].Errors.Errors)
+	assert.LessOrEqual(t, acknowledgments[0].Attempts, 2)
+	assert.Equal(t, "", acknowledgments[0].Event.Id)
+}
@@ -0,0 +1,176 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Archive "github.com/starkbank/sdk-go/starkbank/event/archive"
+	Dispatcher "github.com/starkbank/sdk-go/starkbank/event/dispatcher"
+	InvoiceLog "github.com/starkbank/sdk-go/starkbank/invoice/log"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func archivedEvent(t *testing.T, id string, created time.Time) Event.Event {
+	event := dispatcherEvent(t)
+	event.Id = id
+	event.Created = &created
+	return event
+}
+
+func TestEventArchiveStore(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	directory := t.TempDir()
+	archive := Archive.Archive{Directory: directory, SegmentSize: 2}
+
+	first := time.Date(2021, 4, 26, 20, 16, 51, 0, time.UTC)
+	for i, id := range []string{"3", "1", "2"} {
+		_, err := archive.Store(archivedEvent(t, id, first.AddDate(0, 0, -i)), "", "")
+		if err.Errors != nil {
+			t.Fatal(err.Errors[0].Message)
+		}
+	}
+	defer Utils.UseLocalKey()()
+	_, err := archive.Store(archivedEvent(t, "4", first), dispatcherEventContent, "signature")
+	assert.Equal(t, "invalidSignatureError", err.Errors[0].Code)
+	_, err = archive.Store(archivedEvent(t, "4", first), "", "signature")
+	assert.Equal(t, "invalidSignatureError", err.Errors[0].Code)
+	content := "{\"event\": " + dispatcherEventContent + "}"
+	_, err = archive.Store(archivedEvent(t, "4", first), content, Utils.Sign(content))
+	assert.Equal(t, "invalidSignatureError", err.Errors[0].Code)
+	record, err := archive.Store(Event.Event{}, content, Utils.Sign(content))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "5415223380934656", record.Event.Id)
+	assert.NotEqual(t, "", record.Signature)
+	record, err = archive.Store(archivedEvent(t, "1", first), "", "")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "", record.Signature)
+
+	segments, _ := filepath.Glob(filepath.Join(directory, "*.jsonl"))
+	assert.Len(t, segments, 2)
+
+	reopened := Archive.Archive{Directory: directory, SegmentSize: 2}
+	record, err = reopened.Get("2")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "2", record.Event.Id)
+	log, ok := record.Event.Log.(InvoiceLog.Log)
+	assert.True(t, ok)
+	assert.Equal(t, "5941925571985408", log.Invoice.Id)
+
+	_, err = reopened.Get("4")
+	assert.NotNil(t, err.Errors)
+}
+
+func TestEventArchiveCorruption(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	directory := t.TempDir()
+	archive := Archive.Archive{Directory: directory}
+
+	first := time.Date(2021, 4, 26, 20, 16, 51, 0, time.UTC)
+	for i, id := range []string{"3", "1", "2"} {
+		archive.Store(archivedEvent(t, id, first.AddDate(0, 0, -i)), "", "")
+	}
+
+	path := filepath.Join(directory, "events-000001.jsonl")
+	content, _ := os.ReadFile(path)
+	lines := strings.SplitAfter(string(content), "\n")
+	corrupted := lines[0] + "{\"event\": \n" + lines[1] + lines[2] + lines[2][:10]
+	os.WriteFile(path, []byte(corrupted), 0644)
+
+	reopened := Archive.Archive{Directory: directory}
+	records, err := reopened.Query(nil)
+	assert.Nil(t, err.Errors)
+	assert.Len(t, records, 3)
+
+	corruptions, err := reopened.Corruptions()
+	assert.Nil(t, err.Errors)
+	if assert.Len(t, corruptions, 1) {
+		assert.Equal(t, path, corruptions[0].Path)
+		assert.Equal(t, int64(len(lines[0])), corruptions[0].Offset)
+	}
+
+	truncated, _ := os.ReadFile(path)
+	assert.Equal(t, corrupted[:len(corrupted)-10], string(truncated))
+
+	_, err = reopened.Store(archivedEvent(t, "4", first), "", "")
+	assert.Nil(t, err.Errors)
+	reopened = Archive.Archive{Directory: directory}
+	records, err = reopened.Query(nil)
+	assert.Nil(t, err.Errors)
+	assert.Len(t, records, 4)
+}
+
+func TestEventArchiveQuery(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	archive := Archive.Archive{Directory: t.TempDir()}
+
+	first := time.Date(2021, 4, 26, 20, 16, 51, 0, time.UTC)
+	for i, id := range []string{"3", "1", "2"} {
+		archive.Store(archivedEvent(t, id, first.AddDate(0, 0, -i)), "", "")
+	}
+
+	records, err := archive.Query(nil)
+	assert.Nil(t, err.Errors)
+	assert.Len(t, records, 3)
+	assert.Equal(t, "2", records[0].Event.Id)
+	assert.Equal(t, "3", records[2].Event.Id)
+
+	var params = map[string]interface{}{}
+	params["entityId"] = "5941925571985408"
+	params["subscription"] = "invoice"
+	params["workspaceId"] = "5078376503050240"
+	params["after"] = "2021-04-25"
+	params["before"] = "2021-04-25"
+	records, err = archive.Query(params)
+	assert.Nil(t, err.Errors)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "1", records[0].Event.Id)
+
+	params = map[string]interface{}{}
+	params["ids"] = []string{"3", "2", "5"}
+	params["limit"] = 1
+	records, err = archive.Query(params)
+	assert.Nil(t, err.Errors)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "2", records[0].Event.Id)
+
+	params = map[string]interface{}{}
+	params["entityId"] = "0"
+	records, err = archive.Query(params)
+	assert.Nil(t, err.Errors)
+	assert.Len(t, records, 0)
+}
+
+func TestEventArchiveReplay(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	archive := Archive.Archive{Directory: t.TempDir()}
+
+	first := time.Date(2021, 4, 26, 20, 16, 51, 0, time.UTC)
+	for i, id := range []string{"3", "1", "2"} {
+		archive.Store(archivedEvent(t, id, first.AddDate(0, 0, -i)), "", "")
+	}
+
+	var replayed []string
+	dispatcher := Dispatcher.Dispatcher{}
+	dispatcher.OnInvoice(func(event Event.Event, log InvoiceLog.Log) error {
+		replayed = append(replayed, event.Id)
+		return nil
+	})
+
+	handled, err := archive.Replay(nil, &dispatcher)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 3, handled)
+	assert.Equal(t, []string{"2", "1", "3"}, replayed)
+}
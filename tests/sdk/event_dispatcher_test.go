@@ -0,0 +1,133 @@
+package sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/starkbank/sdk-go/starkbank"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Dispatcher "github.com/starkbank/sdk-go/starkbank/event/dispatcher"
+	InvoiceLog "github.com/starkbank/sdk-go/starkbank/invoice/log"
+	TransferLog "github.com/starkbank/sdk-go/starkbank/transfer/log"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+var dispatcherEventContent = "{\"created\": \"2021-04-26T20:16:51.866857+00:00\", \"id\": \"5415223380934656\", \"log\": {\"created\": \"2021-04-26T20:16:50.927706+00:00\", \"errors\": [], \"id\": \"4687457496858624\", \"invoice\": {\"amount\": 256, \"id\": \"5941925571985408\", \"name\": \"Oscar Cartwright\", \"status\": \"created\", \"taxId\": \"337.451.076-08\"}, \"type\": \"created\"}, \"subscription\": \"invoice\", \"workspaceId\": \"5078376503050240\"}"
+
+func dispatcherEvent(t *testing.T) Event.Event {
+	var event Event.Event
+	err := json.Unmarshal([]byte(dispatcherEventContent), &event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return event
+}
+
+func TestEventDispatcherDispatch(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	var handled []InvoiceLog.Log
+	dispatcher := Dispatcher.Dispatcher{}
+	dispatcher.OnInvoice(func(event Event.Event, log InvoiceLog.Log) error {
+		handled = append(handled, log)
+		return nil
+	})
+	dispatcher.OnTransfer(func(event Event.Event, log TransferLog.Log) error {
+		t.Errorf("unexpected transfer event %s", event.Id)
+		return nil
+	})
+
+	err := dispatcher.Dispatch(dispatcherEvent(t))
+	assert.Nil(t, err)
+	assert.Len(t, handled, 1)
+	assert.Equal(t, "5941925571985408", handled[0].Invoice.Id)
+
+	err = dispatcher.Dispatch(dispatcherEvent(t))
+	assert.Nil(t, err)
+	assert.Len(t, handled, 1)
+}
+
+func TestEventDispatcherHandlerError(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	calls := 0
+	dispatcher := Dispatcher.Dispatcher{}
+	dispatcher.OnInvoice(func(event Event.Event, log InvoiceLog.Log) error {
+		calls++
+		if calls == 1 {
+			return errors.New("database is down")
+		}
+		return nil
+	})
+
+	err := dispatcher.Dispatch(dispatcherEvent(t))
+	assert.NotNil(t, err)
+
+	err = dispatcher.Dispatch(dispatcherEvent(t))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestEventDispatcherInFlight(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	dispatcher := Dispatcher.Dispatcher{}
+	dispatcher.OnInvoice(func(event Event.Event, log InvoiceLog.Log) error {
+		err := dispatcher.Dispatch(event)
+		assert.Equal(t, Dispatcher.ErrInFlight, err)
+		return nil
+	})
+
+	err := dispatcher.Dispatch(dispatcherEvent(t))
+	assert.Nil(t, err)
+}
+
+func TestEventDispatcherRedispatch(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	calls := 0
+	dispatcher := Dispatcher.Dispatcher{}
+	dispatcher.OnInvoice(func(event Event.Event, log InvoiceLog.Log) error {
+		calls++
+		return nil
+	})
+
+	err := dispatcher.Dispatch(dispatcherEvent(t))
+	assert.Nil(t, err)
+
+	err = dispatcher.Dispatch(dispatcherEvent(t))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+
+	err = dispatcher.Redispatch(dispatcherEvent(t))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestEventDispatcherNoHandler(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	dispatcher := Dispatcher.Dispatcher{}
+	dispatcher.OnTransfer(func(event Event.Event, log TransferLog.Log) error {
+		t.Errorf("unexpected transfer event %s", event.Id)
+		return nil
+	})
+
+	err := dispatcher.Dispatch(dispatcherEvent(t))
+	assert.Equal(t, Dispatcher.ErrNoHandler, err)
+
+	calls := 0
+	dispatcher.OnOther(func(event Event.Event) error {
+		calls++
+		return nil
+	})
+	err = dispatcher.Dispatch(dispatcherEvent(t))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+}
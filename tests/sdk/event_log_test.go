@@ -0,0 +1,40 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	CorporatePurchaseLog "github.com/starkbank/sdk-go/starkbank/corporatepurchase/log"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	MerchantSessionLog "github.com/starkbank/sdk-go/starkbank/merchantsession/log"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEventParsedLog(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	log, ok := dispatcherEvent(t).ParsedLog()
+	assert.True(t, ok)
+	assert.Equal(t, "4687457496858624", log.LogId())
+	assert.Equal(t, "created", log.LogType())
+	assert.Equal(t, "5941925571985408", log.EntityId())
+	assert.Equal(t, "created", log.EntityStatus())
+	assert.NotNil(t, log.LogCreated())
+	assert.Empty(t, log.LogErrors())
+
+	_, ok = Event.Event{Subscription: "unknown"}.ParsedLog()
+	assert.False(t, ok)
+}
+
+func TestEventLogErrors(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	purchaseLog := CorporatePurchaseLog.Log{Errors: []map[string]interface{}{{"code": "invalidCard", "message": "Card is invalid"}}}
+	assert.Equal(t, []string{"Card is invalid"}, purchaseLog.LogErrors())
+
+	sessionLog := MerchantSessionLog.Log{Errors: []interface{}{"expired"}}
+	assert.Equal(t, []string{"expired"}, sessionLog.LogErrors())
+
+}
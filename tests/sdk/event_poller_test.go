@@ -0,0 +1,36 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Dispatcher "github.com/starkbank/sdk-go/starkbank/event/dispatcher"
+	Poller "github.com/starkbank/sdk-go/starkbank/event/poller"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEventPollerPoll(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	handled := 0
+	dispatcher := Dispatcher.Dispatcher{}
+	dispatcher.OnOther(func(event Event.Event) error {
+		assert.False(t, event.IsDelivered)
+		handled++
+		return nil
+	})
+
+	var params = map[string]interface{}{}
+	params["limit"] = 5
+
+	poller := Poller.Poller{Dispatcher: &dispatcher, Params: params}
+	delivered, err := poller.Poll()
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	assert.Equal(t, handled, delivered)
+}
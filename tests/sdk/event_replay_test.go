@@ -0,0 +1,125 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Dispatcher "github.com/starkbank/sdk-go/starkbank/event/dispatcher"
+	Replay "github.com/starkbank/sdk-go/starkbank/event/replay"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEventReplayDryRun(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	dispatcher := Dispatcher.Dispatcher{}
+	dispatcher.OnOther(func(event Event.Event) error {
+		t.Errorf("unexpected handler call for event %s", event.Id)
+		return nil
+	})
+
+	var params = map[string]interface{}{}
+	params["after"] = "2020-04-01"
+	params["limit"] = 5
+
+	replayer := Replay.Replayer{Dispatcher: &dispatcher, DryRun: true}
+	result, err := replayer.Run(params)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	assert.LessOrEqual(t, len(result.Events), 5)
+	assert.Empty(t, result.Handled)
+	for i := 1; i < len(result.Events); i++ {
+		assert.False(t, result.Events[i].Created.Before(*result.Events[i-1].Created))
+	}
+}
+
+func TestEventReplayIds(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	ids := undeliveredEventIds(t, 3)
+
+	var handled []string
+	dispatcher := Dispatcher.Dispatcher{}
+	dispatcher.OnOther(func(event Event.Event) error {
+		handled = append(handled, event.Id)
+		return nil
+	})
+
+	var params = map[string]interface{}{}
+	params["ids"] = ids
+
+	replayer := Replay.Replayer{Dispatcher: &dispatcher, KeepUndelivered: true}
+	result, err := replayer.Run(params)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	assert.Equal(t, len(ids), len(result.Handled))
+	assert.Equal(t, len(ids), len(handled))
+	assert.Empty(t, result.Delivered)
+
+	result, err = replayer.Run(params)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, len(ids), len(result.Handled))
+}
+
+func TestEventReplayEntity(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	events, errorChannel := Event.Query(map[string]interface{}{"limit": 1}, nil)
+	var target Event.Event
+	loop:
+	for {
+		select {
+		case err := <-errorChannel:
+			if err.Errors != nil {
+				for _, e := range err.Errors {
+					t.Errorf("code: %s, message: %s", e.Code, e.Message)
+				}
+			}
+		case event, ok := <-events:
+			if !ok {
+				break loop
+			}
+			target = event
+		}
+	}
+	log, ok := target.ParsedLog()
+	if !ok {
+		t.Skip("no event with a typed log")
+	}
+
+	var params = map[string]interface{}{}
+	params["entityId"] = log.EntityId()
+	params["after"] = Date.Of(*target.Created).AddDays(-1)
+	params["limit"] = 20
+
+	replayer := Replay.Replayer{Dispatcher: &Dispatcher.Dispatcher{}, DryRun: true}
+	result, err := replayer.Run(params)
+	assert.Nil(t, err.Errors)
+	for _, event := range result.Events {
+		eventLog, _ := event.ParsedLog()
+		assert.Equal(t, log.EntityId(), eventLog.EntityId())
+	}
+}
+
+func TestEventReplayEntityBound(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	var params = map[string]interface{}{}
+	params["entityId"] = "5656565656565656"
+
+	replayer := Replay.Replayer{Dispatcher: &Dispatcher.Dispatcher{}, DryRun: true}
+	_, err := replayer.Run(params)
+	assert.Equal(t, "invalidReplayParams", err.Errors[0].Code)
+}
@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Invoice "github.com/starkbank/sdk-go/starkbank/invoice"
+	InvoiceLog "github.com/starkbank/sdk-go/starkbank/invoice/log"
+	Sequencer "github.com/starkbank/sdk-go/starkbank/event/sequencer"
+	Transfer "github.com/starkbank/sdk-go/starkbank/transfer"
+	TransferLog "github.com/starkbank/sdk-go/starkbank/transfer/log"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func sequencerTransferEvent(logId string, logType string, created time.Time) Event.Event {
+	return Event.Event{
+		Id:           "event-" + logId,
+		Subscription: "transfer",
+		Log: TransferLog.Log{
+			Id:       logId,
+			Type:     logType,
+			Created:  &created,
+			Transfer: Transfer.Transfer{Id: "5656565656565656"},
+		},
+	}
+}
+
+func sequencerInvoiceEvent(logId string, logType string, created time.Time) Event.Event {
+	return Event.Event{
+		Id:           "event-" + logId,
+		Subscription: "invoice",
+		Log: InvoiceLog.Log{
+			Id:      logId,
+			Type:    logType,
+			Created: &created,
+			Invoice: Invoice.Invoice{Id: "5757575757575757"},
+		},
+	}
+}
+
+func TestEventSequencerOutOfOrder(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	created := time.Date(2020, 3, 10, 10, 30, 0, 0, time.UTC)
+	var sequencer Sequencer.Sequencer
+
+	result, err := sequencer.Commit(sequencerTransferEvent("1", "created", created))
+	assert.Nil(t, err.Errors)
+	assert.True(t, result.Apply)
+	assert.Nil(t, result.Previous)
+
+	result, err = sequencer.Commit(sequencerTransferEvent("3", "success", created.Add(2*time.Minute)))
+	assert.Nil(t, err.Errors)
+	assert.True(t, result.Apply)
+	assert.Equal(t, "created", result.Previous.Type)
+	assert.Equal(t, []string{"processing"}, result.Skipped)
+
+	result, err = sequencer.Check(sequencerTransferEvent("2", "processing", created.Add(time.Minute)))
+	assert.Nil(t, err.Errors)
+	assert.False(t, result.Apply)
+	assert.False(t, result.Duplicate)
+
+	result, err = sequencer.Commit(sequencerTransferEvent("3", "success", created.Add(2*time.Minute)))
+	assert.Nil(t, err.Errors)
+	assert.False(t, result.Apply)
+	assert.True(t, result.Duplicate)
+
+	state, ok := sequencer.Latest("transfer", "5656565656565656")
+	assert.True(t, ok)
+	assert.Equal(t, "success", state.Type)
+}
+
+func TestEventSequencerTypePrecedence(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	created := time.Date(2020, 3, 10, 10, 30, 0, 0, time.UTC)
+	var sequencer Sequencer.Sequencer
+
+	result, _ := sequencer.Commit(sequencerTransferEvent("2", "processing", created))
+	assert.True(t, result.Apply)
+
+	result, _ = sequencer.Commit(sequencerTransferEvent("1", "created", created))
+	assert.False(t, result.Apply)
+
+	result, _ = sequencer.Commit(sequencerTransferEvent("3", "failed", created))
+	assert.True(t, result.Apply)
+	assert.Empty(t, result.Skipped)
+}
+
+func TestEventSequencerInvoiceRegistered(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	created := time.Date(2020, 3, 10, 10, 30, 0, 0, time.UTC)
+	var sequencer Sequencer.Sequencer
+
+	result, _ := sequencer.Commit(sequencerInvoiceEvent("1", "created", created))
+	assert.True(t, result.Apply)
+
+	result, _ = sequencer.Commit(sequencerInvoiceEvent("3", "paid", created.Add(2*time.Minute)))
+	assert.True(t, result.Apply)
+	assert.Equal(t, []string{"registered"}, result.Skipped)
+
+	result, _ = sequencer.Commit(sequencerInvoiceEvent("2", "registered", created.Add(time.Minute)))
+	assert.False(t, result.Apply)
+
+	sequencer = Sequencer.Sequencer{}
+	sequencer.Commit(sequencerInvoiceEvent("1", "created", created))
+	result, _ = sequencer.Commit(sequencerInvoiceEvent("2", "registered", created.Add(time.Minute)))
+	assert.True(t, result.Apply)
+	assert.Empty(t, result.Skipped)
+
+	result, _ = sequencer.Commit(sequencerInvoiceEvent("3", "overdue", created.Add(2*time.Minute)))
+	assert.True(t, result.Apply)
+	assert.Empty(t, result.Skipped)
+}
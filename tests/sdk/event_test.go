@@ -1,13 +1,20 @@
 package sdk
 
 import (
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/publickey"
 	"github.com/starkbank/sdk-go/starkbank"
 	Event "github.com/starkbank/sdk-go/starkbank/event"
 	Attempt "github.com/starkbank/sdk-go/starkbank/event/attempt"
+	SdkUtils "github.com/starkbank/sdk-go/starkbank/utils"
 	Utils "github.com/starkbank/sdk-go/tests/utils"
+	Error "github.com/starkinfra/core-go/starkcore/error"
+	"github.com/starkinfra/core-go/starkcore/user/user"
 	"github.com/stretchr/testify/assert"
 	"math/rand"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestEventGet(t *testing.T) {
@@ -313,3 +320,95 @@ func TestEventMalFormedParse(t *testing.T) {
 		t.Errorf("expected error, got nil")
 	}
 }
+
+func TestEventSubscriptionsParseLog(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	for _, subscription := range Event.Subscriptions {
+		event := Event.Event{
+			Id:           "5415223380934656",
+			Subscription: subscription,
+			Log:          map[string]interface{}{"id": "4687457496858624", "type": "created"},
+		}
+		parsed, err := event.ParseLog()
+		assert.Nil(t, err.Errors)
+		_, isMap := parsed.Log.(map[string]interface{})
+		assert.False(t, isMap, subscription)
+	}
+}
+
+func TestEventLocalKeyParse(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+	defer Utils.UseLocalKey()()
+
+	content := "{\"event\": {\"created\": \"2021-04-26T20:16:51.866857+00:00\", \"id\": \"5415223380934656\", \"log\": {\"created\": \"2021-04-26T20:16:50.927706+00:00\", \"errors\": [], \"id\": \"4687457496858624\", \"invoice\": {\"amount\": 256, \"id\": \"5941925571985408\", \"status\": \"created\"}, \"type\": \"created\"}, \"subscription\": \"invoice\", \"workspaceId\": \"5078376503050240\"}}"
+
+	parsed, err := Event.Parse(content, Utils.Sign(content), nil)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, content, parsed)
+
+	_, err = Event.Parse(content, Utils.Sign(content+" "), nil)
+	assert.NotNil(t, err.Errors)
+
+	_, err = Event.Parse(content, "something is definitely wrong", nil)
+	assert.NotNil(t, err.Errors)
+}
+
+func TestEventKeyRefreshLimit(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	fetches := 0
+	provider := &SdkUtils.CachedPublicKeyProvider{
+		RefreshInterval: time.Hour,
+		Fetch: func(user user.User) (publickey.PublicKey, Error.StarkErrors) {
+			fetches++
+			return Utils.LocalPrivateKey.PublicKey(), Error.StarkErrors{}
+		},
+	}
+	previous := SdkUtils.KeyProvider
+	SdkUtils.KeyProvider = provider
+	defer func() { SdkUtils.KeyProvider = previous }()
+
+	content := "{\"event\": {\"id\": \"5415223380934656\", \"subscription\": \"invoice\"}}"
+	_, err := Event.Parse(content, Utils.Sign(content), nil)
+	assert.Nil(t, err.Errors)
+	for i := 0; i < 5; i++ {
+		_, err = Event.Parse(content, Utils.Sign(content+" "), nil)
+		assert.NotNil(t, err.Errors)
+	}
+	assert.Equal(t, 1, fetches)
+
+	provider.RefreshInterval = time.Nanosecond
+	_, err = Event.Parse(content, Utils.Sign(content+" "), nil)
+	assert.NotNil(t, err.Errors)
+	assert.Equal(t, 2, fetches)
+}
+
+func TestEventKeyFirstFetch(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	var fetches int32
+	provider := &SdkUtils.CachedPublicKeyProvider{
+		Fetch: func(user user.User) (publickey.PublicKey, Error.StarkErrors) {
+			atomic.AddInt32(&fetches, 1)
+			time.Sleep(10 * time.Millisecond)
+			return Utils.LocalPrivateKey.PublicKey(), Error.StarkErrors{}
+		},
+	}
+
+	var group sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			_, err := provider.PublicKey(nil, false)
+			assert.Nil(t, err.Errors)
+		}()
+	}
+	group.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
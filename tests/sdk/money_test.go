@@ -0,0 +1,171 @@
+package sdk
+
+import (
+	"encoding/json"
+	Invoice "github.com/starkbank/sdk-go/starkbank/invoice"
+	Money "github.com/starkbank/sdk-go/starkbank/money"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+)
+
+func TestMoneyParse(t *testing.T) {
+
+	for value, expected := range map[string]Money.Money{
+		"R$ 1.234,56":  {Cents: 123456, Currency: "BRL"},
+		"R$1.234,56":   {Cents: 123456, Currency: "BRL"},
+		"-R$ 1.234,56": {Cents: -123456, Currency: "BRL"},
+		"R$ -0,05":     {Cents: -5, Currency: "BRL"},
+		"1.234.567,8":  {Cents: 123456780, Currency: "BRL"},
+		"1,234.56":     {Cents: 123456, Currency: "BRL"},
+		"1234.56":      {Cents: 123456, Currency: "BRL"},
+		"1234,5":       {Cents: 123450, Currency: "BRL"},
+		"1.234":        {Cents: 123400, Currency: "BRL"},
+		"1,234":        {Cents: 123400, Currency: "BRL"},
+		"12":           {Cents: 1200, Currency: "BRL"},
+		",99":          {Cents: 99, Currency: "BRL"},
+		"US$ 10.00":    {Cents: 1000, Currency: "USD"},
+		"$1,000.10":    {Cents: 100010, Currency: "USD"},
+		"10.00 usd":    {Cents: 1000, Currency: "USD"},
+		"EUR 7,5":      {Cents: 750, Currency: "EUR"},
+		" R$ 0,01 ":    {Cents: 1, Currency: "BRL"},
+	} {
+		parsed, err := Money.Parse(value)
+		assert.Nil(t, err.Errors, value)
+		assert.Equal(t, expected, parsed, value)
+	}
+
+	for _, value := range []string{
+		"",
+		"R$",
+		"abc",
+		"1,234,56",
+		"1.23.4",
+		"12.345,678",
+		"1.234.56",
+		"1,2345",
+		"12,",
+		"1 234,56",
+		"--1",
+		"92233720368547758,08",
+	} {
+		_, err := Money.Parse(value)
+		assert.NotNil(t, err.Errors, value)
+	}
+}
+
+func TestMoneyFormat(t *testing.T) {
+
+	for _, test := range []struct {
+		money Money.Money
+		ptBr  string
+		en    string
+	}{
+		{Money.New(123456), "R$ 1.234,56", "R$1,234.56"},
+		{Money.New(-5), "-R$ 0,05", "-R$0.05"},
+		{Money.Money{}, "R$ 0,00", "R$0.00"},
+		{Money.Money{Cents: 100000000, Currency: "USD"}, "US$ 1.000.000,00", "$1,000,000.00"},
+		{Money.Money{Cents: 1999, Currency: "GBP"}, "GBP 19,99", "GBP 19.99"},
+		{Money.Money{Cents: math.MinInt64}, "-R$ 92.233.720.368.547.758,08", "-R$92,233,720,368,547,758.08"},
+	} {
+		assert.Equal(t, test.ptBr, test.money.Format("pt-BR"))
+		assert.Equal(t, test.ptBr, test.money.String())
+		assert.Equal(t, test.en, test.money.Format("en"))
+
+		parsed, err := Money.Parse(test.ptBr)
+		if test.money.Cents != math.MinInt64 {
+			assert.Nil(t, err.Errors, test.ptBr)
+			assert.Equal(t, test.money.Cents, parsed.Cents, test.ptBr)
+		}
+	}
+	assert.Equal(t, "-1234.56", Money.New(-123456).Decimal())
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+
+	sum, err := Money.New(1050).Add(Money.Money{Cents: 250})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, Money.New(1300), sum)
+
+	difference, err := Money.New(1050).Sub(Money.New(2000))
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, Money.New(-950), difference)
+	assert.True(t, difference.IsNegative())
+
+	product, err := Money.New(333).Multiply(3)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 999, product.Int())
+
+	_, err = Money.New(math.MaxInt64).Add(Money.New(1))
+	assert.Equal(t, "moneyOverflow", err.Errors[0].Code)
+	_, err = Money.New(math.MinInt64).Sub(Money.New(1))
+	assert.Equal(t, "moneyOverflow", err.Errors[0].Code)
+	_, err = Money.New(0).Sub(Money.New(math.MinInt64))
+	assert.Equal(t, "moneyOverflow", err.Errors[0].Code)
+	_, err = Money.New(math.MaxInt64/2 + 1).Multiply(2)
+	assert.Equal(t, "moneyOverflow", err.Errors[0].Code)
+	_, err = Money.New(math.MinInt64).Multiply(-1)
+	assert.Equal(t, "moneyOverflow", err.Errors[0].Code)
+	_, err = Money.New(100).Add(Money.Money{Cents: 100, Currency: "USD"})
+	assert.Equal(t, "currencyMismatch", err.Errors[0].Code)
+}
+
+func TestMoneyAllocate(t *testing.T) {
+
+	parts, err := Money.New(10).Split(3)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, []Money.Money{Money.New(4), Money.New(3), Money.New(3)}, parts)
+
+	parts, err = Money.New(-10).Split(3)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, []Money.Money{Money.New(-4), Money.New(-3), Money.New(-3)}, parts)
+
+	parts, err = Money.New(100005).Allocate(70, 20, 10, 0)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, []Money.Money{Money.New(70004), Money.New(20001), Money.New(10000), Money.New(0)}, parts)
+
+	for _, cents := range []int{0, 1, 99, 12345, math.MaxInt64, math.MinInt64 + 1} {
+		for _, ratios := range [][]int{{1}, {1, 1}, {1, 2, 3}, {0, 7, 0, 13}, {math.MaxInt32, 1}} {
+			parts, err := Money.New(cents).Allocate(ratios...)
+			assert.Nil(t, err.Errors)
+			total := Money.New(0)
+			for _, part := range parts {
+				total, err = total.Add(part)
+				assert.Nil(t, err.Errors)
+			}
+			assert.Equal(t, cents, total.Int(), ratios)
+		}
+	}
+
+	_, err = Money.New(10).Split(0)
+	assert.Equal(t, "invalidMoney", err.Errors[0].Code)
+	_, err = Money.New(10).Allocate(1, -1)
+	assert.Equal(t, "invalidMoney", err.Errors[0].Code)
+	_, err = Money.New(10).Allocate()
+	assert.Equal(t, "invalidMoney", err.Errors[0].Code)
+}
+
+func TestMoneyJson(t *testing.T) {
+
+	invoice := Invoice.Invoice{Amount: 123456, Fee: 50}
+	data, _ := json.Marshal(invoice)
+
+	var payload struct {
+		Amount Money.Money `json:"amount"`
+		Fee    Money.Money `json:"fee"`
+	}
+	assert.Nil(t, json.Unmarshal(data, &payload))
+	assert.Equal(t, "R$ 1.234,56", payload.Amount.String())
+	assert.Equal(t, Money.New(50), payload.Fee)
+
+	payload.Amount, _ = payload.Amount.Add(Money.New(44))
+	data, _ = json.Marshal(payload)
+	assert.Equal(t, `{"amount":123500,"fee":50}`, string(data))
+
+	var decoded Invoice.Invoice
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, 123500, decoded.Amount)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`"R$ 1,00"`), &payload.Amount))
+	assert.NotNil(t, json.Unmarshal([]byte(`1.5`), &payload.Amount))
+}
@@ -1,12 +1,17 @@
 package sdk
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/starkbank/sdk-go/starkbank"
+	Date "github.com/starkbank/sdk-go/starkbank/date"
 	PaymentPreview "github.com/starkbank/sdk-go/starkbank/paymentpreview"
 	Utils "github.com/starkbank/sdk-go/tests/utils"
 	Example "github.com/starkbank/sdk-go/tests/utils/examples"
 	"github.com/stretchr/testify/assert"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestBoletoPreviewPost(t *testing.T) {
@@ -71,3 +76,63 @@ func TestUtilityPreviewPost(t *testing.T) {
 		assert.Equal(t, preview.Type, "utility-payment")
 	}
 }
+
+func TestPaymentPreviewParse(t *testing.T) {
+
+	var previews []PaymentPreview.PaymentPreview
+	assert.Nil(t, json.Unmarshal([]byte(`[
+		{"id": "34191.09008", "type": "boleto-payment", "scheduled": "2024-03-10", "payment": {"amount": 23456, "due": "2024-03-12T02:59:59.999999+00:00", "name": "Iron Bank"}},
+		{"id": "00020126", "type": "brcode-payment", "scheduled": "2024-03-10", "payment": {"amount": 100, "allowChange": true}},
+		{"id": "85660000006", "type": "tax-payment", "payment": {"amount": 500, "name": "Iron Throne"}},
+		{"id": "83660000001", "type": "utility-payment", "payment": {"amount": 700}}
+	]`), &previews))
+
+	parsed, err := PaymentPreview.ParsePreviews(previews)
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, Date.New(2024, time.March, 10), *parsed[0].Scheduled)
+
+	boleto, ok := parsed[0].AsBoleto()
+	assert.True(t, ok)
+	assert.Equal(t, 23456, boleto.Amount)
+	assert.Equal(t, Date.New(2024, time.March, 11), *boleto.Due)
+	_, ok = parsed[0].AsBrcode()
+	assert.False(t, ok)
+
+	brcode, ok := parsed[1].AsBrcode()
+	assert.True(t, ok)
+	assert.True(t, brcode.AllowChange)
+
+	tax, ok := parsed[2].AsTax()
+	assert.True(t, ok)
+	assert.Equal(t, "Iron Throne", tax.Name)
+
+	utility, ok := parsed[3].AsUtility()
+	assert.True(t, ok)
+	assert.Equal(t, 700, utility.Amount)
+	_, ok = parsed[3].AsTax()
+	assert.False(t, ok)
+
+	_, isMap := previews[0].Payment.(map[string]interface{})
+	assert.True(t, isMap)
+}
+
+func TestPaymentPreviewParseConcurrently(t *testing.T) {
+
+	var wait sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wait.Add(1)
+		go func(amount int) {
+			defer wait.Done()
+			preview, err := PaymentPreview.PaymentPreview{
+				Type:    "tax-payment",
+				Payment: map[string]interface{}{"amount": amount, "name": fmt.Sprint(amount)},
+			}.ParsePreview()
+			assert.Nil(t, err.Errors)
+			tax, ok := preview.AsTax()
+			assert.True(t, ok)
+			assert.Equal(t, amount, tax.Amount)
+			assert.Equal(t, fmt.Sprint(amount), tax.Name)
+		}(i + 1)
+	}
+	wait.Wait()
+}
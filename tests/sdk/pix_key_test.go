@@ -0,0 +1,101 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	DictKey "github.com/starkbank/sdk-go/starkbank/dictkey"
+	PixKey "github.com/starkbank/sdk-go/starkbank/pixkey"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
+	Transfer "github.com/starkbank/sdk-go/starkbank/transfer"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestPixKeyParse(t *testing.T) {
+
+	for key, expected := range map[string]PixKey.PixKey{
+		" Tony@StarkBank.com ":                   {Type: PixKey.Email, Value: "tony@starkbank.com"},
+		"B6295EE1-F054-47D1-9E90-EE57B74F60D9":   {Type: PixKey.Evp, Value: "b6295ee1-f054-47d1-9e90-ee57b74f60d9"},
+		"b6295ee1f05447d19e90ee57b74f60d9":       {Type: PixKey.Evp, Value: "b6295ee1-f054-47d1-9e90-ee57b74f60d9"},
+		"722.461.430-04":                         {Type: PixKey.Cpf, Value: "72246143004"},
+		"72246143004":                            {Type: PixKey.Cpf, Value: "72246143004"},
+		"20.018.183/0001-80":                     {Type: PixKey.Cnpj, Value: "20018183000180"},
+		"20018183000180":                         {Type: PixKey.Cnpj, Value: "20018183000180"},
+		"12ABC34501DE35":                         {Type: PixKey.Cnpj, Value: "12ABC34501DE35"},
+		"+5511988887777":                         {Type: PixKey.Phone, Value: "+5511988887777"},
+		"+55 (11) 98888-7777":                    {Type: PixKey.Phone, Value: "+5511988887777"},
+		"(11) 98888-7777":                        {Type: PixKey.Phone, Value: "+5511988887777"},
+		"11988887777":                            {Type: PixKey.Phone, Value: "+5511988887777"},
+		"5511988887777":                          {Type: PixKey.Phone, Value: "+5511988887777"},
+	} {
+		parsed, err := PixKey.Parse(key)
+		assert.Nil(t, err.Errors, key)
+		assert.Equal(t, expected, parsed, key)
+	}
+
+	for _, key := range []string{
+		"",
+		"tony@",
+		"tony@starkbank",
+		"722.461.430-05",
+		"20.018.183/0001-81",
+		"+1 212 555 0100",
+		"(10) 98888-7777",
+		"(11) 8888-7777",
+		"1198888777",
+		"b6295ee1-f054-47d1-9e90",
+		"not a key",
+	} {
+		_, err := PixKey.Parse(key)
+		if assert.NotNil(t, err.Errors, key) {
+			assert.Equal(t, "invalidPixKey", err.Errors[0].Code)
+		}
+		assert.Equal(t, "", PixKey.Detect(key))
+	}
+
+	assert.Equal(t, PixKey.Phone, PixKey.Detect("(11) 98888-7777"))
+	normalized, err := PixKey.Normalize("722.461.430-04")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "72246143004", normalized)
+}
+
+func TestPixKeyToTransfer(t *testing.T) {
+
+	transfer := PixKey.ToTransfer(DictKey.DictKey{
+		Id:            "tony@starkbank.com",
+		Type:          "email",
+		Name:          "Tony Stark",
+		TaxId:         "***.345.678-**",
+		Ispb:          "20018183",
+		BranchCode:    "ZW5jcnlwdGVkLWJyYW5jaC1jb2Rl",
+		AccountNumber: "ZW5jcnlwdGVkLWFjY291bnQtbnVtYmVy",
+		AccountType:   "checking",
+	}, 1234)
+
+	assert.Equal(t, Transfer.Transfer{
+		Amount:        1234,
+		Name:          "Tony Stark",
+		TaxId:         "***.345.678-**",
+		BankCode:      "20018183",
+		BranchCode:    "ZW5jcnlwdGVkLWJyYW5jaC1jb2Rl",
+		AccountNumber: "ZW5jcnlwdGVkLWFjY291bnQtbnVtYmVy",
+		AccountType:   "checking",
+	}, transfer)
+	assert.True(t, TaxId.IsMasked(transfer.TaxId))
+}
+
+func TestPixKeyGet(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	_, err := PixKey.Get("tony@", nil)
+	assert.Equal(t, "invalidPixKey", err.Errors[0].Code)
+
+	dictKey, err := PixKey.Get(" Tony@StarkBank.com ", nil)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	assert.Equal(t, "tony@starkbank.com", dictKey.Id)
+}
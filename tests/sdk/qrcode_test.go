@@ -0,0 +1,356 @@
+package sdk
+
+import (
+	"bytes"
+	"fmt"
+	Brcode "github.com/starkbank/sdk-go/starkbank/brcode"
+	DynamicBrcode "github.com/starkbank/sdk-go/starkbank/dynamicbrcode"
+	Invoice "github.com/starkbank/sdk-go/starkbank/invoice"
+	Qrcode "github.com/starkbank/sdk-go/starkbank/qrcode"
+	"github.com/stretchr/testify/assert"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+var qrFormatStrings = map[string][8]string{
+	"L": {"111011111000100", "111001011110011", "111110110101010", "111100010011101", "110011000101111", "110001100011000", "110110001000001", "110100101110110"},
+	"M": {"101010000010010", "101000100100101", "101111001111100", "101101101001011", "100010111111001", "100000011001110", "100111110010111", "100101010100000"},
+	"Q": {"011010101011111", "011000001101000", "011111100110001", "011101000000110", "010010010110100", "010000110000011", "010111011011010", "010101111101101"},
+	"H": {"001011010001001", "001001110111110", "001110011100111", "001100111010000", "000011101100010", "000001001010101", "000110100001100", "000100000111011"},
+}
+
+type qrLayout struct {
+	alignments []int
+	blocks     [][2]int
+	ecc        int
+}
+
+var qrLayouts = map[string]qrLayout{
+	"1-M":  {nil, [][2]int{{1, 16}}, 10},
+	"5-Q":  {[]int{6, 30}, [][2]int{{2, 15}, {2, 16}}, 18},
+	"7-M":  {[]int{6, 22, 38}, [][2]int{{4, 31}}, 18},
+	"8-M":  {[]int{6, 24, 42}, [][2]int{{2, 38}, {2, 39}}, 22},
+	"10-M": {[]int{6, 28, 50}, [][2]int{{4, 43}, {1, 44}}, 26},
+}
+
+func TestQrcodeEncodeCapacity(t *testing.T) {
+
+	for level, capacity := range map[string]int{"L": 17, "M": 14, "Q": 11, "H": 7} {
+		code, err := Qrcode.Encode(strings.Repeat("a", capacity), level)
+		assert.Nil(t, err.Errors)
+		assert.Equal(t, 1, code.Version)
+		assert.Equal(t, 21, code.Size)
+
+		code, err = Qrcode.Encode(strings.Repeat("a", capacity+1), level)
+		assert.Nil(t, err.Errors)
+		assert.Equal(t, 2, code.Version)
+	}
+
+	code, err := Qrcode.Encode(strings.Repeat("a", 2953), "L")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, 40, code.Version)
+	assert.Equal(t, 177, code.Size)
+
+	_, err = Qrcode.Encode(strings.Repeat("a", 2954), "L")
+	assert.Equal(t, "invalidQrcode", err.Errors[0].Code)
+
+	_, err = Qrcode.Encode(strings.Repeat("a", 1274), "H")
+	assert.Equal(t, "invalidQrcode", err.Errors[0].Code)
+
+	_, err = Qrcode.Encode("starkbank", "X")
+	assert.Equal(t, "invalidQrcode", err.Errors[0].Code)
+}
+
+func TestQrcodeEncodeRoundTrip(t *testing.T) {
+
+	cases := []struct {
+		content string
+		level   string
+		version int
+	}{
+		{"starkbank", "M", 1},
+		{strings.Repeat("Stark Bank ", 5)[:50], "Q", 5},
+		{strings.Repeat("0123456789", 11), "m", 7},
+		{strings.Repeat("Pix ", 50), "", 10},
+	}
+	for _, c := range cases {
+		code, err := Qrcode.Encode(c.content, c.level)
+		assert.Nil(t, err.Errors)
+		assert.Equal(t, c.version, code.Version)
+		assert.Equal(t, strings.ToUpper(c.level)+map[bool]string{true: "M"}[c.level == ""], code.Level)
+		assert.Equal(t, c.content, qrRead(t, code))
+	}
+}
+
+func TestQrcodeEncodeBrcode(t *testing.T) {
+
+	brcode, err := Brcode.Generate(Brcode.Brcode{
+		PixKey:       "tony@starkbank.com",
+		MerchantName: "Stark Bank S.A.",
+		MerchantCity: "Sao Paulo",
+		Amount:       1234,
+		TxId:         "invoice123",
+	})
+	assert.Nil(t, err.Errors)
+
+	code, errors := Qrcode.Encode(brcode, "M")
+	assert.Nil(t, errors.Errors)
+	assert.Equal(t, 8, code.Version)
+	assert.Equal(t, brcode, qrRead(t, code))
+}
+
+func TestQrcodePng(t *testing.T) {
+
+	content := "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+	code, err := Qrcode.Encode(content, "M")
+	assert.Nil(t, err.Errors)
+
+	blob, err := Qrcode.Png(content, map[string]interface{}{"size": 3, "quietZone": 2})
+	assert.Nil(t, err.Errors)
+	img, decodeErr := png.Decode(bytes.NewReader(blob))
+	assert.Nil(t, decodeErr)
+	side := (code.Size + 4) * 3
+	assert.Equal(t, side, img.Bounds().Dx())
+	assert.Equal(t, side, img.Bounds().Dy())
+
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			red, _, _, _ := img.At((x+2)*3+1, (y+2)*3+1).RGBA()
+			assert.Equal(t, code.IsDark(x, y), red == 0)
+		}
+	}
+	red, _, _, _ := img.At(0, 0).RGBA()
+	assert.NotZero(t, red)
+
+	blob, err = Qrcode.Png(content, nil)
+	assert.Nil(t, err.Errors)
+	img, _ = png.Decode(bytes.NewReader(blob))
+	assert.Equal(t, (code.Size+8)*7, img.Bounds().Dx())
+
+	_, err = Qrcode.Png(content, map[string]interface{}{"size": 51})
+	assert.Equal(t, "invalidQrcode", err.Errors[0].Code)
+}
+
+func TestQrcodeSvg(t *testing.T) {
+
+	code, err := Qrcode.Encode("stark", "H")
+	assert.Nil(t, err.Errors)
+
+	blob, err := Qrcode.Svg("stark", map[string]interface{}{"level": "H", "size": 10})
+	assert.Nil(t, err.Errors)
+	svg := string(blob)
+	assert.True(t, strings.HasPrefix(svg, "<svg "))
+	assert.Contains(t, svg, `width="290" height="290" viewBox="0 0 29 29"`)
+
+	dark := 0
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			if code.IsDark(x, y) {
+				dark++
+			}
+		}
+	}
+	assert.Equal(t, dark, strings.Count(svg, "h1v1h-1z"))
+	assert.Contains(t, svg, "M4,4h1v1h-1z")
+
+	_, err = Qrcode.Svg("stark", map[string]interface{}{"level": 1})
+	assert.Equal(t, "invalidQrcode", err.Errors[0].Code)
+}
+
+func TestQrcodeInvoiceAndDynamicBrcode(t *testing.T) {
+
+	content := "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D"
+	expected, err := Qrcode.Png(content, map[string]interface{}{"size": 5})
+	assert.Nil(t, err.Errors)
+
+	blob, err := Invoice.Invoice{Brcode: content}.QrcodePng(map[string]interface{}{"size": 5})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, expected, blob)
+
+	blob, err = DynamicBrcode.DynamicBrcode{Id: content}.QrcodePng(map[string]interface{}{"size": 5})
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, expected, blob)
+
+	_, err = Invoice.Invoice{Amount: 400000}.QrcodeSvg(nil)
+	assert.Equal(t, "invalidQrcode", err.Errors[0].Code)
+}
+
+func qrRead(t *testing.T, code Qrcode.Code) string {
+	size := code.Size
+	layout, ok := qrLayouts[fmt.Sprintf("%d-%s", code.Version, code.Level)]
+	if !ok {
+		t.Fatalf("no layout for version %d-%s", code.Version, code.Level)
+	}
+
+	format := ""
+	for _, position := range [][2]int{{8, 0}, {8, 1}, {8, 2}, {8, 3}, {8, 4}, {8, 5}, {8, 7}, {8, 8}, {7, 8}, {5, 8}, {4, 8}, {3, 8}, {2, 8}, {1, 8}, {0, 8}} {
+		format = map[bool]string{true: "1", false: "0"}[code.IsDark(position[0], position[1])] + format
+	}
+	copied := ""
+	for i := 0; i < 15; i++ {
+		x, y := size-1-i, 8
+		if i >= 8 {
+			x, y = 8, size-15+i
+		}
+		copied = map[bool]string{true: "1", false: "0"}[code.IsDark(x, y)] + copied
+	}
+	assert.Equal(t, qrFormatStrings[code.Level][code.Mask], format)
+	assert.Equal(t, format, copied)
+	assert.True(t, code.IsDark(8, size-8))
+
+	if code.Version == 7 {
+		version := ""
+		for i := 0; i < 18; i++ {
+			bit := code.IsDark(size-11+i%3, i/3)
+			assert.Equal(t, bit, code.IsDark(i/3, size-11+i%3))
+			version = map[bool]string{true: "1", false: "0"}[bit] + version
+		}
+		assert.Equal(t, "000111110010010100", version)
+	}
+
+	reserved := func(x int, y int) bool {
+		if (x < 9 && y < 9) || (x >= size-8 && y < 9) || (x < 9 && y >= size-8) || x == 6 || y == 6 {
+			return true
+		}
+		if code.Version >= 7 && ((x >= size-11 && x < size-8 && y < 6) || (y >= size-11 && y < size-8 && x < 6)) {
+			return true
+		}
+		last := len(layout.alignments) - 1
+		for i, ax := range layout.alignments {
+			for j, ay := range layout.alignments {
+				if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+					continue
+				}
+				if x >= ax-2 && x <= ax+2 && y >= ay-2 && y <= ay+2 {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	masks := []func(x int, y int) bool{
+		func(x int, y int) bool { return (x+y)%2 == 0 },
+		func(x int, y int) bool { return y%2 == 0 },
+		func(x int, y int) bool { return x%3 == 0 },
+		func(x int, y int) bool { return (x+y)%3 == 0 },
+		func(x int, y int) bool { return (y/2+x/3)%2 == 0 },
+		func(x int, y int) bool { return (x*y)%2+(x*y)%3 == 0 },
+		func(x int, y int) bool { return ((x*y)%2+(x*y)%3)%2 == 0 },
+		func(x int, y int) bool { return ((x+y)%2+(x*y)%3)%2 == 0 },
+	}
+
+	var codewords []byte
+	var current byte
+	count := 0
+	for right := size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vertical := 0; vertical < size; vertical++ {
+			y := vertical
+			if (right+1)&2 == 0 {
+				y = size - 1 - vertical
+			}
+			for x := right; x >= right-1; x-- {
+				if reserved(x, y) {
+					continue
+				}
+				current = current<<1 | map[bool]byte{true: 1, false: 0}[code.IsDark(x, y) != masks[code.Mask](x, y)]
+				count++
+				if count%8 == 0 {
+					codewords = append(codewords, current)
+					current = 0
+				}
+			}
+		}
+	}
+
+	var blocks [][]byte
+	for _, group := range layout.blocks {
+		for i := 0; i < group[0]; i++ {
+			blocks = append(blocks, make([]byte, 0, group[1]+layout.ecc))
+		}
+	}
+	lengths := func(b int) int {
+		index := 0
+		for _, group := range layout.blocks {
+			if b < index+group[0] {
+				return group[1]
+			}
+			index += group[0]
+		}
+		return 0
+	}
+	position := 0
+	for i := 0; i < lengths(len(blocks)-1); i++ {
+		for b := range blocks {
+			if i < lengths(b) {
+				blocks[b] = append(blocks[b], codewords[position])
+				position++
+			}
+		}
+	}
+	for i := 0; i < layout.ecc; i++ {
+		for b := range blocks {
+			blocks[b] = append(blocks[b], codewords[position])
+			position++
+		}
+	}
+
+	var data []byte
+	for b, block := range blocks {
+		for i := 0; i < layout.ecc; i++ {
+			assert.Equal(t, byte(0), qrSyndrome(block, i), "block %d syndrome %d", b, i)
+		}
+		data = append(data, block[:lengths(b)]...)
+	}
+
+	bitAt := func(i int) int { return int(data[i/8]>>uint(7-i%8)) & 1 }
+	read := func(from int, length int) int {
+		value := 0
+		for i := from; i < from+length; i++ {
+			value = value<<1 | bitAt(i)
+		}
+		return value
+	}
+	assert.Equal(t, 4, read(0, 4))
+	countBits := 8
+	if code.Version >= 10 {
+		countBits = 16
+	}
+	length := read(4, countBits)
+	content := make([]byte, length)
+	for i := range content {
+		content[i] = byte(read(4+countBits+8*i, 8))
+	}
+	return string(content)
+}
+
+func qrSyndrome(block []byte, power int) byte {
+	root := byte(1)
+	for i := 0; i < power; i++ {
+		root = qrMultiply(root, 2)
+	}
+	result := byte(0)
+	for _, b := range block {
+		result = qrMultiply(result, root) ^ b
+	}
+	return result
+}
+
+func qrMultiply(x byte, y byte) byte {
+	result := byte(0)
+	for y > 0 {
+		if y&1 == 1 {
+			result ^= x
+		}
+		carry := x&0x80 != 0
+		x <<= 1
+		if carry {
+			x ^= 0x1D
+		}
+		y >>= 1
+	}
+	return result
+}
@@ -0,0 +1,130 @@
+package sdk
+
+import (
+	"encoding/json"
+	BrcodePayment "github.com/starkbank/sdk-go/starkbank/brcodepayment"
+	BrcodePaymentRules "github.com/starkbank/sdk-go/starkbank/brcodepayment/rules"
+	DynamicBrcode "github.com/starkbank/sdk-go/starkbank/dynamicbrcode"
+	DynamicBrcodeRule "github.com/starkbank/sdk-go/starkbank/dynamicbrcode/rule"
+	Invoice "github.com/starkbank/sdk-go/starkbank/invoice"
+	InvoiceRule "github.com/starkbank/sdk-go/starkbank/invoice/rule"
+	Transfer "github.com/starkbank/sdk-go/starkbank/transfer"
+	TransferRule "github.com/starkbank/sdk-go/starkbank/transfer/rule"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestRuleTransfer(t *testing.T) {
+
+	rule := TransferRule.ResendingLimit(5)
+	assert.Equal(t, TransferRule.Rule{Key: "resendingLimit", Value: 5}, rule)
+	limit, ok := rule.ResendingLimit()
+	assert.True(t, ok)
+	assert.Equal(t, 5, limit)
+
+	assert.Nil(t, TransferRule.Check([]TransferRule.Rule{
+		rule,
+		TransferRule.ResendingLimit(0),
+		TransferRule.ResendingLimit(50),
+		{Key: "resendingLimit", Value: int64(5)},
+		{Key: "resendingLimit", Value: float64(5)},
+		{Key: "resendLimit", Value: "any"},
+	}).Errors)
+
+	limit, ok = TransferRule.Rule{Key: "resendingLimit", Value: float64(3)}.ResendingLimit()
+	assert.True(t, ok)
+	assert.Equal(t, 3, limit)
+
+	err := TransferRule.Check([]TransferRule.Rule{
+		TransferRule.ResendingLimit(-1),
+		{Key: "resendingLimit", Value: "5"},
+		{Key: "resendingLimit", Value: 2.5},
+	})
+	assert.Equal(t, 3, len(err.Errors))
+	for _, e := range err.Errors {
+		assert.Equal(t, "invalidRule", e.Code)
+	}
+
+	_, err = Transfer.Create([]Transfer.Transfer{{
+		Amount:        100,
+		Name:          "Tony Stark",
+		TaxId:         "012.345.678-90",
+		BankCode:      "20018183",
+		BranchCode:    "0001",
+		AccountNumber: "10000-0",
+		Rules:         []TransferRule.Rule{TransferRule.ResendingLimit(-1)},
+	}}, nil)
+	assert.Equal(t, "invalidRule", err.Errors[0].Code)
+
+	var transfer Transfer.Transfer
+	assert.Nil(t, json.Unmarshal([]byte(`{"rules": [{"key": "resendingLimit", "value": 3}]}`), &transfer))
+	limit, ok = transfer.Rules[0].ResendingLimit()
+	assert.True(t, ok)
+	assert.Equal(t, 3, limit)
+	assert.Equal(t, TransferRule.ResendingLimit(3), transfer.Rules[0])
+}
+
+func TestRuleBrcodePayment(t *testing.T) {
+
+	assert.Nil(t, BrcodePaymentRules.Check([]BrcodePaymentRules.Rule{BrcodePaymentRules.ResendingLimit(10)}).Errors)
+	assert.Nil(t, BrcodePaymentRules.Check([]BrcodePaymentRules.Rule{{Key: "allowedTaxIds", Value: []string{"012.345.678-90"}}}).Errors)
+
+	_, err := BrcodePayment.Create([]BrcodePayment.BrcodePayment{{
+		Brcode:      "00020101021226890014br.gov.bcb.pix2567invoice-h.sandbox.starkbank.com/v2/d6a6d9fb8c6b48bc9e2a3b1b08f1a59c5204000053039865802BR5915Stark Bank S.A.6009Sao Paulo62070503***6304C6D5",
+		TaxId:       "20.018.183/0001-80",
+		Description: "payment ABC",
+		Rules:       []BrcodePaymentRules.Rule{{Key: "resendingLimit", Value: 2.5}},
+	}}, nil)
+	assert.Equal(t, "invalidRule", err.Errors[0].Code)
+
+	var payment BrcodePayment.BrcodePayment
+	assert.Nil(t, json.Unmarshal([]byte(`{"rules": [{"key": "resendingLimit", "value": 7}, {"key": "other", "value": ["a"]}]}`), &payment))
+	assert.Equal(t, BrcodePaymentRules.ResendingLimit(7), payment.Rules[0])
+	_, ok := payment.Rules[1].ResendingLimit()
+	assert.False(t, ok)
+	assert.Equal(t, []interface{}{"a"}, payment.Rules[1].Value)
+}
+
+func TestRuleInvoice(t *testing.T) {
+
+	rule := InvoiceRule.AllowedTaxIds("012.345.678-90", "20.018.183/0001-80")
+	taxIds, ok := rule.AllowedTaxIds()
+	assert.True(t, ok)
+	assert.Equal(t, []string{"012.345.678-90", "20.018.183/0001-80"}, taxIds)
+	assert.Nil(t, InvoiceRule.Check([]InvoiceRule.Rule{rule, {Key: "allowedTaxIds", Value: "012.345.678-90"}}).Errors)
+
+	err := InvoiceRule.Check([]InvoiceRule.Rule{
+		InvoiceRule.AllowedTaxIds(),
+		InvoiceRule.AllowedTaxIds("012.345.678-91"),
+		{Key: "allowedTaxIds", Value: 5},
+		{Key: "allowedTaxId", Value: []string{"012.345.678-90"}},
+	})
+	assert.Equal(t, 3, len(err.Errors))
+
+	_, err = Invoice.Create([]Invoice.Invoice{{
+		Amount: 100,
+		Name:   "Tony Stark",
+		TaxId:  "012.345.678-90",
+		Rules:  []InvoiceRule.Rule{InvoiceRule.AllowedTaxIds("123")},
+	}}, nil)
+	assert.Equal(t, "invalidRule", err.Errors[0].Code)
+
+	var invoice Invoice.Invoice
+	assert.Nil(t, json.Unmarshal([]byte(`{"rules": [{"key": "allowedTaxIds", "value": ["012.345.678-90"]}]}`), &invoice))
+	assert.Equal(t, InvoiceRule.AllowedTaxIds("012.345.678-90"), invoice.Rules[0])
+}
+
+func TestRuleDynamicBrcode(t *testing.T) {
+
+	rule := DynamicBrcodeRule.AllowedTaxIds("012.345.678-90")
+	taxIds, ok := rule.AllowedTaxIds()
+	assert.True(t, ok)
+	assert.Equal(t, []string{"012.345.678-90"}, taxIds)
+
+	_, err := DynamicBrcode.Create([]DynamicBrcode.DynamicBrcode{{
+		Amount: 100,
+		Rules:  []DynamicBrcodeRule.Rule{DynamicBrcodeRule.AllowedTaxIds(), {Key: "allowedTaxId", Value: []string{"012.345.678-90"}}},
+	}}, nil)
+	assert.Equal(t, 1, len(err.Errors))
+	assert.Equal(t, "invalidRule", err.Errors[0].Code)
+}
@@ -0,0 +1,85 @@
+package sdk
+
+import (
+	"encoding/json"
+	Boleto "github.com/starkbank/sdk-go/starkbank/boleto"
+	CorporateCard "github.com/starkbank/sdk-go/starkbank/corporatecard"
+	CorporatePurchase "github.com/starkbank/sdk-go/starkbank/corporatepurchase"
+	Invoice "github.com/starkbank/sdk-go/starkbank/invoice"
+	Transfer "github.com/starkbank/sdk-go/starkbank/transfer"
+	TransferLog "github.com/starkbank/sdk-go/starkbank/transfer/log"
+	"github.com/starkbank/sdk-go/starkbank/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestStatusTransfer(t *testing.T) {
+
+	assert.False(t, Transfer.Created.IsTerminal())
+	assert.False(t, Transfer.Processing.IsTerminal())
+	assert.True(t, Transfer.Success.IsTerminal())
+	assert.True(t, Transfer.Failed.IsTerminal())
+	assert.True(t, Transfer.Canceled.IsTerminal())
+	assert.False(t, Transfer.Status("unknown").IsTerminal())
+
+	assert.True(t, Transfer.Success.IsSuccessful())
+	assert.False(t, Transfer.Failed.IsSuccessful())
+	assert.False(t, Transfer.Processing.IsSuccessful())
+
+	assert.True(t, Transfer.Created.CanTransitionTo(Transfer.Processing))
+	assert.True(t, Transfer.Created.CanTransitionTo(Transfer.Canceled))
+	assert.True(t, Transfer.Processing.CanTransitionTo(Transfer.Success))
+	assert.False(t, Transfer.Processing.CanTransitionTo(Transfer.Canceled))
+	assert.False(t, Transfer.Success.CanTransitionTo(Transfer.Failed))
+	assert.False(t, Transfer.Created.CanTransitionTo(Transfer.Created))
+}
+
+func TestStatusLifecycles(t *testing.T) {
+
+	assert.True(t, Invoice.Overdue.CanTransitionTo(Invoice.Paid))
+	assert.True(t, Invoice.Paid.IsSuccessful())
+	assert.False(t, Invoice.Paid.IsTerminal())
+	assert.True(t, Invoice.Voided.IsTerminal())
+
+	assert.True(t, Boleto.Registered.CanTransitionTo(Boleto.Overdue))
+	assert.False(t, Boleto.Paid.CanTransitionTo(Boleto.Canceled))
+
+	assert.True(t, CorporateCard.Blocked.CanTransitionTo(CorporateCard.Active))
+	assert.False(t, CorporateCard.Canceled.CanTransitionTo(CorporateCard.Active))
+	assert.True(t, CorporateCard.Active.IsSuccessful())
+
+	assert.True(t, CorporatePurchase.Approved.IsSuccessful())
+	assert.True(t, CorporatePurchase.Denied.IsTerminal())
+}
+
+func TestStatusJson(t *testing.T) {
+
+	var transfer Transfer.Transfer
+	assert.Nil(t, json.Unmarshal([]byte(`{"id": "5656565656565656", "status": "processing"}`), &transfer))
+	assert.Equal(t, Transfer.Processing, transfer.Status)
+	assert.True(t, transfer.Status.CanTransitionTo(Transfer.Success))
+
+	log := TransferLog.Log{Transfer: transfer}
+	assert.Equal(t, "processing", log.EntityStatus())
+
+	data, _ := json.Marshal(Transfer.Transfer{Status: Transfer.Success})
+	assert.Contains(t, string(data), `"Status":"success"`)
+}
+
+func TestStatusQueryParams(t *testing.T) {
+
+	params := map[string]interface{}{
+		"status": Invoice.Paid,
+		"tags":   []string{"a", "b"},
+		"limit":  10,
+	}
+	casted := utils.CastParams(params)
+	assert.Equal(t, "paid", casted["status"])
+	assert.Equal(t, []string{"a", "b"}, casted["tags"])
+	assert.Equal(t, 10, casted["limit"])
+	assert.Equal(t, Invoice.Paid, params["status"])
+
+	casted = utils.CastParams(map[string]interface{}{"status": []Transfer.Status{Transfer.Success, Transfer.Failed}})
+	assert.Equal(t, []string{"success", "failed"}, casted["status"])
+	assert.Nil(t, utils.CastParams(nil))
+}
@@ -0,0 +1,76 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	Boleto "github.com/starkbank/sdk-go/starkbank/boleto"
+	TaxId "github.com/starkbank/sdk-go/starkbank/taxid"
+	Transfer "github.com/starkbank/sdk-go/starkbank/transfer"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTaxIdType(t *testing.T) {
+
+	assert.Equal(t, TaxId.Cpf, TaxId.Type("012.345.678-90"))
+	assert.Equal(t, TaxId.Cpf, TaxId.Type("01234567890"))
+	assert.Equal(t, TaxId.Cpf, TaxId.Type("330.731.970-10"))
+	assert.Equal(t, TaxId.Cnpj, TaxId.Type("20.018.183/0001-80"))
+	assert.Equal(t, TaxId.Cnpj, TaxId.Type("38446231000104"))
+	assert.Equal(t, TaxId.Cnpj, TaxId.Type("12.ABC.345/01DE-35"))
+	assert.Equal(t, TaxId.Cnpj, TaxId.Type("12.abc.345/01de-35"))
+
+	assert.Equal(t, "", TaxId.Type("012.345.678-91"))
+	assert.Equal(t, "", TaxId.Type("20.018.183/0001-81"))
+	assert.Equal(t, "", TaxId.Type("12.ABC.345/01DE-3A"))
+	assert.Equal(t, "", TaxId.Type("111.111.111-11"))
+	assert.Equal(t, "", TaxId.Type("00.000.000/0000-00"))
+	assert.Equal(t, "", TaxId.Type("ABC.DEF.GHI-JK"))
+	assert.Equal(t, "", TaxId.Type("0123456789"))
+	assert.Equal(t, "", TaxId.Type(""))
+}
+
+func TestTaxIdFormat(t *testing.T) {
+
+	formatted, err := TaxId.Format("01234567890")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "012.345.678-90", formatted)
+
+	formatted, err = TaxId.Format("20018183000180")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "20.018.183/0001-80", formatted)
+
+	formatted, err = TaxId.Format("12abc34501de35")
+	assert.Nil(t, err.Errors)
+	assert.Equal(t, "12.ABC.345/01DE-35", formatted)
+
+	_, err = TaxId.Format("20018183000181")
+	assert.Equal(t, "invalidTaxId", err.Errors[0].Code)
+
+	assert.Equal(t, "12ABC34501DE35", TaxId.Normalize(" 12.abc.345/01de-35 "))
+}
+
+func TestTaxIdCheck(t *testing.T) {
+
+	assert.Nil(t, TaxId.Check("012.345.678-90", "", "45.059.493/0001-73").Errors)
+	assert.Len(t, TaxId.Check("012.345.678-91", "20.018.183/0001-81").Errors, 2)
+	assert.Len(t, TaxId.Check("***.345.678-**", "012.345.678-9*").Errors, 2)
+
+	assert.True(t, TaxId.IsMasked("***.345.678-**"))
+	for _, taxId := range []string{"012.345.678-90", "***.345.678-9*", "***.3a5.678-**", "***345678**", "**.***.***/0001-**"} {
+		assert.False(t, TaxId.IsMasked(taxId), taxId)
+	}
+}
+
+func TestTaxIdCreateValidation(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	_, err := Transfer.Create([]Transfer.Transfer{{Amount: 100, Name: "Tony Stark", TaxId: "012.345.678-91"}}, nil)
+	assert.Len(t, err.Errors, 1)
+	assert.Equal(t, "invalidTaxId", err.Errors[0].Code)
+
+	_, err = Boleto.Create([]Boleto.Boleto{{Amount: 400000, Name: "Iron Bank S.A.", TaxId: "20.018.183/0001-80", ReceiverTaxId: "123"}}, nil)
+	assert.Len(t, err.Errors, 1)
+	assert.Equal(t, "invalidTaxId", err.Errors[0].Code)
+}
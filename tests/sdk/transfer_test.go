@@ -138,7 +138,41 @@ func TestTransferQuery(t *testing.T) {
 	}
 
 	for _, transfer := range transferList {
-		assert.Equal(t, transfer.Status, "success")
+		assert.Equal(t, params["status"], string(transfer.Status))
+	}
+}
+
+func TestTransferQueryTypedStatus(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	limit := 10
+	var params = map[string]interface{}{}
+	params["status"] = transfer.Success
+	params["limit"] = limit
+
+	var transferList []transfer.Transfer
+
+	transfers, errorChannel := transfer.Query(params, nil)
+	loop:
+	for {
+		select {
+		case err := <-errorChannel:
+			if err.Errors != nil {
+				for _, e := range err.Errors {
+					t.Errorf("code: %s, message: %s", e.Code, e.Message)
+				}
+			}
+		case transfer, ok := <-transfers:
+			if !ok {
+				break loop
+			}
+			transferList = append(transferList, transfer)
+		}
+	}
+
+	for _, transfer := range transferList {
+		assert.Equal(t, params["status"], transfer.Status)
 	}
 }
 
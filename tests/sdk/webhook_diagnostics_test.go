@@ -0,0 +1,102 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	Event "github.com/starkbank/sdk-go/starkbank/event"
+	Attempt "github.com/starkbank/sdk-go/starkbank/event/attempt"
+	Webhook "github.com/starkbank/sdk-go/starkbank/webhook"
+	Diagnostics "github.com/starkbank/sdk-go/starkbank/webhook/diagnostics"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestWebhookDiagnosticsGenerate(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	var params = map[string]interface{}{}
+	params["after"] = time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+
+	report, err := Diagnostics.Generate(params, nil)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	assert.NotNil(t, report.Text())
+}
+
+func TestWebhookDiagnosticsGenerateDefaultWindow(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	report, err := Diagnostics.Generate(nil, nil)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	assert.NotNil(t, report.Text())
+}
+
+func TestWebhookDiagnosticsSummarize(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	first := time.Date(2020, 3, 10, 10, 30, 0, 0, time.UTC)
+	second := first.Add(time.Minute)
+	third := first.Add(time.Hour)
+
+	webhooks := []Webhook.Webhook{
+		{Id: "1", Url: "https://example.com/a", Subscriptions: []string{"transfer", "invoice"}},
+	}
+	events := []Event.Event{
+		{Id: "10", Subscription: "transfer", Created: &first, IsDelivered: false},
+		{Id: "11", Subscription: "transfer", Created: &second, IsDelivered: true},
+		{Id: "12", Subscription: "transfer", Created: &third, IsDelivered: true},
+		{Id: "13", Subscription: "invoice", Created: &third, IsDelivered: true},
+	}
+	attempts := []Attempt.Attempt{
+		{Id: "100", EventId: "10", WebhookId: "1", Code: "badHttpStatus", Message: "HTTP POST request returned status 404", Created: &third},
+		{Id: "101", EventId: "10", WebhookId: "1", Code: "timeout", Message: "Request timed out", Created: &second},
+		{Id: "102", EventId: "11", WebhookId: "1", Code: "badHttpStatus", Message: "HTTP POST request returned status 404", Created: &second},
+	}
+
+	report := Diagnostics.Summarize(webhooks, events, attempts)
+
+	assert.Len(t, report.Webhooks, 1)
+	assert.Len(t, report.Webhooks[0].Subscriptions, 2)
+
+	invoice := report.Webhooks[0].Subscriptions[0]
+	assert.Equal(t, "invoice", invoice.Subscription)
+	assert.Equal(t, 0.0, invoice.FailureRate)
+	assert.Nil(t, invoice.OldestUndelivered)
+
+	transfer := report.Webhooks[0].Subscriptions[1]
+	assert.Equal(t, "transfer", transfer.Subscription)
+	assert.Equal(t, 3, transfer.Events)
+	assert.Equal(t, 2, transfer.FailedEvents)
+	assert.Equal(t, 3, transfer.Attempts)
+	assert.InDelta(t, 2.0/3.0, transfer.FailureRate, 0.0001)
+	assert.Equal(t, Diagnostics.Count{Value: "badHttpStatus", Count: 2}, transfer.Codes[0])
+	assert.Equal(t, "10", transfer.OldestUndelivered.Id)
+	if assert.Len(t, transfer.Timeline, 2) {
+		assert.Equal(t, "10", transfer.Timeline[0].EventId)
+		assert.Equal(t, "11", transfer.Timeline[1].EventId)
+		if assert.Len(t, transfer.Timeline[0].Attempts, 2) {
+			assert.Equal(t, "101", transfer.Timeline[0].Attempts[0].Id)
+			assert.Equal(t, "100", transfer.Timeline[0].Attempts[1].Id)
+		}
+	}
+
+	json, err := report.Json()
+	assert.Nil(t, err)
+	assert.Contains(t, json, "\"failureRate\"")
+	assert.Contains(t, report.Text(), "https://example.com/a")
+	assert.NotContains(t, report.Text(), "Events not found")
+
+	report.MissingEventIds = []string{"12"}
+	assert.Contains(t, report.Text(), "Events not found: 12")
+}
@@ -0,0 +1,67 @@
+package sdk
+
+import (
+	"github.com/starkbank/sdk-go/starkbank"
+	Webhook "github.com/starkbank/sdk-go/starkbank/webhook"
+	Reconciler "github.com/starkbank/sdk-go/starkbank/webhook/reconciler"
+	Utils "github.com/starkbank/sdk-go/tests/utils"
+	Example "github.com/starkbank/sdk-go/tests/utils/examples"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestWebhookReconcilerPrepare(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	plan, err := Reconciler.Prepare([]Webhook.Webhook{Example.Webhook()}, nil)
+	if err.Errors != nil {
+		for _, e := range err.Errors {
+			t.Errorf("code: %s, message: %s", e.Code, e.Message)
+		}
+	}
+	assert.Len(t, plan.Create, 1)
+}
+
+func TestWebhookReconcilerDiff(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	desired := []Webhook.Webhook{
+		{Url: "https://example.com/same", Subscriptions: []string{"transfer", "invoice"}},
+		{Url: "https://example.com/changed", Subscriptions: []string{"deposit"}},
+		{Url: "https://example.com/new", Subscriptions: []string{"boleto"}},
+	}
+	existing := []Webhook.Webhook{
+		{Id: "1", Url: "https://example.com/same", Subscriptions: []string{"invoice", "transfer"}},
+		{Id: "2", Url: "https://example.com/same", Subscriptions: []string{"invoice", "transfer"}},
+		{Id: "3", Url: "https://example.com/changed", Subscriptions: []string{"deposit", "boleto"}},
+		{Id: "4", Url: "https://example.com/old", Subscriptions: []string{"transfer"}},
+	}
+
+	plan := Reconciler.Diff(desired, existing)
+
+	assert.Len(t, plan.Keep, 1)
+	assert.Equal(t, "1", plan.Keep[0].Id)
+	assert.Len(t, plan.Delete, 3)
+	assert.Len(t, plan.Create, 2)
+	assert.Equal(t, "https://example.com/changed", plan.Create[0].Url)
+	assert.Equal(t, "https://example.com/new", plan.Create[1].Url)
+	assert.False(t, plan.IsEmpty())
+	assert.True(t, Reconciler.Diff(desired[:1], existing[:1]).IsEmpty())
+}
+
+func TestWebhookReconcilerValidate(t *testing.T) {
+
+	starkbank.User = Utils.ExampleProject
+
+	err := Reconciler.Validate([]Webhook.Webhook{
+		{Url: "https://example.com/a", Subscriptions: []string{"transfer", "invoices"}},
+		{Url: "https://example.com/a", Subscriptions: []string{"deposit"}},
+		{Url: "", Subscriptions: []string{"deposit"}},
+	})
+	assert.Len(t, err.Errors, 3)
+
+	err = Reconciler.Validate([]Webhook.Webhook{Example.Webhook()})
+	assert.Nil(t, err.Errors)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/starkbank/sdk-go/starkbank/corporateinvoice"
 	"github.com/starkbank/sdk-go/starkbank/corporatewithdrawal"
 	"github.com/starkbank/sdk-go/starkbank/darfpayment"
+	"github.com/starkbank/sdk-go/starkbank/date"
 	"github.com/starkbank/sdk-go/starkbank/dynamicbrcode"
 	DynamicBrCodeRule "github.com/starkbank/sdk-go/starkbank/dynamicbrcode/rule"
 	"github.com/starkbank/sdk-go/starkbank/invoice"
@@ -143,7 +144,7 @@ func BoletosPayment() []boletopayment.BoletoPayment {
 
 func Boleto() []boleto.Boleto {
 
-	due := time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 0, 0, 0, 0, time.UTC)
+	due := date.Today()
 
 	boletos := []boleto.Boleto{
 		{
@@ -194,8 +195,8 @@ func BrcodePayment() []brcodepayment.BrcodePayment {
 
 func Darf() []darfpayment.DarfPayment {
 
-	competence := time.Date(2022, 10, 28, 0, 0, 0, 0, time.UTC)
-	due := time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 0, 0, 0, 0, time.UTC)
+	competence := date.New(2022, time.October, 28)
+	due := date.Today()
 
 	payments := []darfpayment.DarfPayment{
 		{
@@ -347,10 +348,11 @@ func PaymentPreviewBrcode() []paymentpreview.PaymentPreview {
 		invoiceList = append(invoiceList, invoice)
 	}
 
+	scheduled := date.Today()
 	previews := []paymentpreview.PaymentPreview{
 		{
 			Id:        invoiceList[rand.Intn(len(invoiceList))].Brcode,
-			Scheduled: time.Date(time.Now().Year(), time.Now().Month(), time.Now().Day(), 0, 0, 0, 0, time.UTC),
+			Scheduled: &scheduled,
 		},
 	}
 	return previews
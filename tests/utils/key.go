@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/curve"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/ecdsa"
+	"github.com/starkbank/ecdsa-go/v2/ellipticcurve/privatekey"
+	SdkUtils "github.com/starkbank/sdk-go/starkbank/utils"
+)
+
+var LocalPrivateKey = privatekey.New(curve.Secp256k1)
+
+func Sign(content string) string {
+	return ecdsa.Sign(content, &LocalPrivateKey).ToBase64()
+}
+
+func UseLocalKey() func() {
+	previous := SdkUtils.KeyProvider
+	SdkUtils.KeyProvider = SdkUtils.StaticPublicKeyProvider{Key: LocalPrivateKey.PublicKey()}
+	return func() {
+		SdkUtils.KeyProvider = previous
+	}
+}